@@ -2,17 +2,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
-	"sync"
+	"syscall"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/config"
 	"github.com/emmaLP/gs-software-onboarding/internal/consumer"
-	"github.com/emmaLP/gs-software-onboarding/internal/grpc"
 	"github.com/emmaLP/gs-software-onboarding/internal/logging"
 	"github.com/emmaLP/gs-software-onboarding/internal/queue"
-	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"go.uber.org/zap"
 )
 
@@ -23,7 +25,7 @@ func main() {
 	go func() {
 		// handle interrupts and propagate the changes across the consumer pipeline
 		c := make(chan os.Signal, 1)
-		signal.Notify(c, os.Interrupt)
+		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 		<-c
 		cancel()
 	}()
@@ -44,34 +46,66 @@ func main() {
 	if err != nil {
 		logger.Fatal("Failed to load config", zap.Error(err))
 	}
-	qClient, err := queue.New(logger, ctx, &configuration.RabbitMq)
+	if configuration.RabbitMq.Backend == queue.MemoryBackend {
+		// The publisher runs in its own process, so no messages would ever be received
+		logger.Fatal("The memory queue backend only delivers messages within one process, use cmd/local to run the publisher and consumer together", zap.String("backend", configuration.RabbitMq.Backend))
+	}
+	qClient, err := queue.Open(logger, ctx, &configuration.RabbitMq)
 	if err != nil {
 		logger.Fatal("Failed to instantiate queue client", zap.Error(err))
 	}
 	defer qClient.CloseConnection()
 
-	grpcClient, err := grpc.NewClient(configuration.GrpcClient.GrpcAddress, logger)
-	if err != nil {
-		logger.Fatal("Unable to create GRPC client.", zap.Error(err))
+	if len(os.Args) > 1 && os.Args[1] == "dlq" {
+		dlqClient, ok := qClient.(deadLetterClient)
+		if !ok {
+			logger.Fatal("The queue backend does not support dead-lettered messages", zap.String("backend", configuration.RabbitMq.Backend))
+		}
+		if err := runDeadLetters(dlqClient, os.Args[2:]); err != nil {
+			logger.Fatal("Failed to handle dead-lettered messages", zap.Error(err))
+		}
+		return
 	}
-	defer grpcClient.Close()
-	logger.Info("GRPC client connected to server")
-	wg := sync.WaitGroup{}
-	itemChan := make(chan *commonModel.Item)
 
-	consumerClient := consumer.New(logger, grpcClient)
-	for i := 0; i < configuration.Consumer.NumberOfWorkers; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			consumerClient.ProcessMessages(ctx, itemChan)
-		}()
+	if err := consumer.Run(logger, configuration, qClient); err != nil {
+		logger.Fatal("Failed to consume messages", zap.Error(err))
 	}
+}
 
-	err = qClient.ReceiveMessage(itemChan)
-	if err != nil {
-		logger.Fatal("Unable to consumer messages from rabbitmq.", zap.Error(err))
+// runDeadLetters lists the dead-lettered messages as JSON lines, or replays them onto the queue
+func runDeadLetters(qClient deadLetterClient, args []string) error {
+	flags := flag.NewFlagSet("dlq", flag.ExitOnError)
+	limit := flags.Int("limit", 0, "maximum number of messages to list or replay, all of them when 0")
+	if len(args) == 0 {
+		return errors.New("Expected a dlq command, either list or replay")
+	}
+	if err := flags.Parse(args[1:]); err != nil {
+		return fmt.Errorf("Unable to parse flags. %w", err)
 	}
-	close(itemChan)
-	wg.Wait()
+
+	switch args[0] {
+	case "list":
+		deadLetters, err := qClient.ListDeadLetters(*limit)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		for _, deadLetter := range deadLetters {
+			if err := encoder.Encode(deadLetter); err != nil {
+				return fmt.Errorf("Unable to print message. %w", err)
+			}
+		}
+	case "replay":
+		replayed, err := qClient.ReplayDeadLetters(*limit)
+		fmt.Printf("Replayed %d messages\n", replayed)
+		return err
+	default:
+		return fmt.Errorf("Unsupported dlq command %q, expected list or replay", args[0])
+	}
+	return nil
+}
+
+type deadLetterClient interface {
+	ListDeadLetters(limit int) ([]queue.DeadLetter, error)
+	ReplayDeadLetters(limit int) (int, error)
 }
@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/caching"
 	"github.com/emmaLP/gs-software-onboarding/internal/config"
@@ -38,6 +42,16 @@ func main() {
 	}
 	defer databaseClient.CloseConnection(ctx)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrations(ctx, databaseClient, os.Args[2:]); err != nil {
+			logger.Fatal("Failed to migrate the database", zap.Error(err))
+		}
+		return
+	}
+	if _, err := databaseClient.Migrate(ctx); err != nil {
+		logger.Fatal("Failed to migrate the database", zap.Error(err))
+	}
+
 	cacheClient, err := caching.New(ctx, configuration.Cache.Address, databaseClient, logger)
 	if err != nil {
 		logger.Fatal("Unexpected error when connecting to the cache.", zap.Error(err))
@@ -52,3 +66,46 @@ func main() {
 	}
 	defer grpcServer.Stop()
 }
+
+// runMigrations applies the pending migrations, or prints the status of every migration
+func runMigrations(ctx context.Context, dbClient migrator, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+	if len(args) > 1 {
+		return errors.New("Expected a single migrate command, either up or status")
+	}
+
+	switch command {
+	case "up":
+		applied, err := dbClient.Migrate(ctx)
+		for _, migration := range applied {
+			fmt.Printf("Applied migration %d: %s\n", migration.Version, migration.Description)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("The database is up to date")
+		}
+		return err
+	case "status":
+		statuses, err := dbClient.Migrations(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range statuses {
+			appliedAt := "pending"
+			if !migration.AppliedAt.IsZero() {
+				appliedAt = "applied " + migration.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%d %s: %s\n", migration.Version, appliedAt, migration.Description)
+		}
+	default:
+		return fmt.Errorf("Unsupported migrate command %q, expected up or status", command)
+	}
+	return nil
+}
+
+type migrator interface {
+	Migrate(ctx context.Context) ([]database.MigrationStatus, error)
+	Migrations(ctx context.Context) ([]database.MigrationStatus, error)
+}
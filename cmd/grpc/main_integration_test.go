@@ -43,7 +43,7 @@ func TestGrpcServer_ListStories(t *testing.T) {
 		},
 		"Successfully list 2 stories": {
 			itemsToSave:      []*commonModel.Item{&story, &job, &story2},
-			expectedResponse: []*commonModel.Item{&story, &story2},
+			expectedResponse: []*commonModel.Item{&story2, &story},
 		},
 	}
 
@@ -61,12 +61,12 @@ func TestGrpcServer_ListStories(t *testing.T) {
 			assert.NoError(t, err)
 			defer client.Close()
 
-			stories, err := client.ListStories(ctx)
+			page, err := client.ListStories(ctx, commonModel.PageRequest{})
 			assert.NoError(t, err)
-			assert.NotNil(t, stories)
+			assert.NotNil(t, page)
 
-			assert.Len(t, stories, len(testConfig.expectedResponse))
-			assert.Equal(t, testConfig.expectedResponse, stories)
+			assert.Len(t, page.Items, len(testConfig.expectedResponse))
+			assert.Equal(t, testConfig.expectedResponse, page.Items)
 
 			t.Cleanup(func() {
 				client.Close()
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/logging"
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews/hntest"
+	"go.uber.org/zap"
+)
+
+func main() {
+	address := flag.String("address", ":8000", "address to serve the fake Hacker News API on")
+	datasetPath := flag.String("dataset", "", "JSON fixture file to serve, the built-in dataset is used when empty")
+	latency := flag.Duration("latency", 0, "delay added to every response")
+	errorRate := flag.Float64("error-rate", 0, "fraction of requests answered with a 503")
+	nullRate := flag.Float64("null-rate", 0, "fraction of item and user requests answered with null")
+	flag.Parse()
+
+	logger, err := logging.New()
+	if err != nil {
+		log.Fatal("Failed to configure the logger", err)
+	}
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			log.Fatal("Failed to perform log sync")
+		}
+	}(logger)
+
+	dataset := hntest.DefaultDataset()
+	if *datasetPath != "" {
+		dataset, err = hntest.LoadDataset(*datasetPath)
+		if err != nil {
+			logger.Fatal("Failed to load the dataset", zap.Error(err))
+		}
+	}
+
+	server := hntest.New(dataset,
+		hntest.WithLatency(*latency),
+		hntest.WithErrorRate(*errorRate),
+		hntest.WithNullRate(*nullRate))
+	logger.Info("Starting fake Hacker News API", zap.String("address", *address))
+	if err := http.ListenAndServe(*address, server); err != nil {
+		logger.Fatal("Fake Hacker News API stopped", zap.Error(err))
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/config"
+	"github.com/emmaLP/gs-software-onboarding/internal/consumer"
+	"github.com/emmaLP/gs-software-onboarding/internal/logging"
+	"github.com/emmaLP/gs-software-onboarding/internal/publisher"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	"go.uber.org/zap"
+)
+
+// main runs the publisher and consumer in one process, so that they can exchange messages through the in-memory queue
+// backend. The GRPC server still runs on its own
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		// handle interrupts and propagate the changes across the publisher and consumer pipelines
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		<-c
+		cancel()
+	}()
+
+	logger, err := logging.New()
+	if err != nil {
+		log.Fatal("Failed to configure the logger", err)
+	}
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			log.Fatal("Failed to perform log sync")
+		}
+	}(logger)
+
+	configuration, err := config.LoadConfig(".")
+	if err != nil {
+		logger.Fatal("Failed to load config", zap.Error(err))
+	}
+
+	// The consumer's queue is declared before anything is published, as messages that match no queue are dropped
+	qClient, err := queue.Open(logger, ctx, &configuration.RabbitMq)
+	if err != nil {
+		logger.Fatal("Failed to instantiate queue client", zap.Error(err))
+	}
+	defer qClient.CloseConnection()
+
+	go func() {
+		if err := publisher.ConfigureCron(ctx, logger, configuration); err != nil {
+			logger.Error("Failed to configure the cron", zap.Error(err))
+			cancel()
+		}
+	}()
+
+	if err := consumer.Run(logger, configuration, qClient); err != nil {
+		logger.Fatal("Failed to consume messages", zap.Error(err))
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"os/signal"
@@ -9,6 +10,7 @@ import (
 	"github.com/emmaLP/gs-software-onboarding/internal/config"
 	"github.com/emmaLP/gs-software-onboarding/internal/logging"
 	"github.com/emmaLP/gs-software-onboarding/internal/publisher"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
 	"go.uber.org/zap"
 )
 
@@ -40,6 +42,27 @@ func main() {
 	if err != nil {
 		logger.Fatal("Failed to load config", zap.Error(err))
 	}
+	if configuration.RabbitMq.Backend == queue.MemoryBackend {
+		// The consumer runs in its own process, so it would never receive the published messages
+		logger.Fatal("The memory queue backend only delivers messages within one process, use cmd/local to run the publisher and consumer together", zap.String("backend", configuration.RabbitMq.Backend))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "backfill" {
+		// Flags override the backfill settings loaded from the config
+		flags := flag.NewFlagSet("backfill", flag.ExitOnError)
+		flags.IntVar(&configuration.Publisher.BackfillMinID, "min-id", configuration.Publisher.BackfillMinID, "lowest item id to publish")
+		flags.StringVar(&configuration.Publisher.BackfillSince, "since", configuration.Publisher.BackfillSince, "stop once items created before this date (YYYY-MM-DD or RFC3339) are reached")
+		flags.IntVar(&configuration.Publisher.BackfillWorkers, "workers", configuration.Publisher.BackfillWorkers, "number of items fetched concurrently")
+		flags.IntVar(&configuration.Publisher.BackfillBatchSize, "batch-size", configuration.Publisher.BackfillBatchSize, "number of items published between checkpoints")
+		if err := flags.Parse(os.Args[2:]); err != nil {
+			logger.Fatal("Failed to parse backfill flags", zap.Error(err))
+		}
+
+		if err := publisher.RunBackfill(ctx, logger, configuration); err != nil {
+			logger.Fatal("Failed to backfill items", zap.Error(err))
+		}
+		return
+	}
 
 	if err := publisher.ConfigureCron(ctx, logger, configuration); err != nil {
 		logger.Fatal("Failed to configure the cron", zap.Error(err))
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -16,7 +17,7 @@ import (
 func setupRequest(t *testing.T, path string) (*httptest.ResponseRecorder, echo.Context) {
 	t.Helper()
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(context.TODO())
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetPath(path)
@@ -25,7 +26,8 @@ func setupRequest(t *testing.T, path string) (*httptest.ResponseRecorder, echo.C
 }
 
 type successResponse struct {
-	Items []commonModel.Item `json:"items"`
+	Items      []commonModel.Item `json:"items"`
+	NextCursor string             `json:"next_cursor"`
 }
 
 func decodeRequest(t *testing.T, body io.Reader) (res successResponse) {
@@ -2,9 +2,14 @@ package api
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/grpc"
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
@@ -14,12 +19,16 @@ type Handler interface {
 	GetAll(c echo.Context) error
 	ListStories(c echo.Context) error
 	ListJobs(c echo.Context) error
+	Search(c echo.Context) error
+	GetItem(c echo.Context) error
+	GetUser(c echo.Context) error
 	Close(ctx context.Context)
 }
 
 type apiHandler struct {
 	logger     *zap.Logger
 	grpcClient grpc.Client
+	now        func() time.Time
 }
 
 // HandlerOptions give the ability to inject optional struct variables or override others
@@ -30,36 +39,118 @@ func NewHandler(logger *zap.Logger, client grpc.Client) (*apiHandler, error) {
 	return &apiHandler{
 		logger:     logger,
 		grpcClient: client,
+		now:        time.Now,
 	}, nil
 }
 
 func (h *apiHandler) GetAll(c echo.Context) error {
-	all, err := h.grpcClient.ListAll(c.Request().Context())
+	return h.listItems(c, "items", h.grpcClient.ListAll)
+}
+
+func (h *apiHandler) ListStories(c echo.Context) error {
+	return h.listItems(c, "stories", h.grpcClient.ListStories)
+}
+
+func (h *apiHandler) ListJobs(c echo.Context) error {
+	return h.listItems(c, "jobs", h.grpcClient.ListJobs)
+}
+
+// listItems responds with the page of items requested by the query params, along with the cursor of the next page,
+// which is empty on the last page
+func (h *apiHandler) listItems(c echo.Context, name string, listFunc func(context.Context, model.PageRequest) (*model.ItemPage, error)) error {
+	page, err := parsePageRequest(c.QueryParams(), h.now())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving items"))
+		return c.JSON(http.StatusBadRequest, h.errorResponse(err, err.Error()))
+	}
+
+	itemPage, err := listFunc(c.Request().Context(), page)
+	if err != nil {
+		if errors.Is(err, grpc.ErrInvalidArgument) {
+			return c.JSON(http.StatusBadRequest, h.errorResponse(err, "Invalid filter or cursor"))
+		}
+		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving "+name))
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"items": all,
+		"items":       itemPage.Items,
+		"next_cursor": itemPage.NextCursor,
 	})
 }
 
-func (h *apiHandler) ListStories(c echo.Context) error {
-	stories, err := h.grpcClient.ListStories(c.Request().Context())
+// Search responds with the page of items matching the q query param, most relevant first, along with snippets of the
+// title and text of each item with the matching words highlighted. It accepts the same filter params as the lists
+func (h *apiHandler) Search(c echo.Context) error {
+	query := c.QueryParam("q")
+	if strings.TrimSpace(query) == "" {
+		err := errors.New("Query param q is required")
+		return c.JSON(http.StatusBadRequest, h.errorResponse(err, err.Error()))
+	}
+	if c.QueryParam("sort") != "" {
+		err := errors.New("Search results are always sorted by relevance")
+		return c.JSON(http.StatusBadRequest, h.errorResponse(err, err.Error()))
+	}
+	page, err := parsePageRequest(c.QueryParams(), h.now())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving stories"))
+		return c.JSON(http.StatusBadRequest, h.errorResponse(err, err.Error()))
+	}
+
+	searchPage, err := h.grpcClient.Search(c.Request().Context(), model.SearchRequest{
+		Query:  query,
+		Limit:  page.Limit,
+		Cursor: page.Cursor,
+		Filter: page.Filter,
+	})
+	if err != nil {
+		if errors.Is(err, grpc.ErrInvalidArgument) {
+			return c.JSON(http.StatusBadRequest, h.errorResponse(err, "Invalid filter or cursor"))
+		}
+		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error searching items"))
+	}
+
+	terms := searchTerms(query)
+	results := make([]map[string]interface{}, len(searchPage.Results))
+	for i, result := range searchPage.Results {
+		results[i] = map[string]interface{}{
+			"item":      result.Item,
+			"relevance": result.Relevance,
+			"highlights": map[string]string{
+				"title": highlight(result.Item.Title, terms),
+				"text":  snippet(result.Item.Text, terms),
+			},
+		}
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"items": stories,
+		"results":     results,
+		"next_cursor": searchPage.NextCursor,
 	})
 }
 
-func (h *apiHandler) ListJobs(c echo.Context) error {
-	jobs, err := h.grpcClient.ListJobs(c.Request().Context())
+func (h *apiHandler) GetItem(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, h.errorResponse(err, "Item id must be a number"))
+	}
+	item, err := h.grpcClient.GetItem(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, grpc.ErrNotFound) {
+			return c.JSON(http.StatusNotFound, h.errorResponse(err, "Item not found"))
+		}
+		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving item"))
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"item": item,
+	})
+}
+
+func (h *apiHandler) GetUser(c echo.Context) error {
+	user, err := h.grpcClient.GetUser(c.Request().Context(), c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving jobs"))
+		if errors.Is(err, grpc.ErrNotFound) {
+			return c.JSON(http.StatusNotFound, h.errorResponse(err, "User not found"))
+		}
+		return c.JSON(http.StatusInternalServerError, h.errorResponse(err, "Error retrieving user"))
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"items": jobs,
+		"user": user,
 	})
 }
 
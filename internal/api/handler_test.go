@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -17,18 +19,20 @@ func TestGetAll(t *testing.T) {
 	tests := map[string]struct {
 		grpcMock             *grpc.Mock
 		expectedMocks        func(t *testing.T, grpcMock *grpc.Mock)
+		query                string
 		expectedStatusCode   int
 		expectedResultLength int
+		expectedCursor       string
 	}{
 		"Successfully ListAll": {
 			expectedStatusCode:   200,
 			expectedResultLength: 2,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
-				grpcMock.On("ListAll", context.TODO()).Return([]*commonModel.Item{
+				grpcMock.On("ListAll", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
 					{ID: 2, Type: "job"},
-				}, nil)
+				}}, nil)
 			},
 		},
 		"Failed to get data": {
@@ -36,7 +40,37 @@ func TestGetAll(t *testing.T) {
 			expectedResultLength: 0,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
-				grpcMock.On("ListAll", context.TODO()).Return(nil, errors.New("Failed to find item"))
+				grpcMock.On("ListAll", context.TODO(), commonModel.PageRequest{}).Return(nil, errors.New("Failed to find item"))
+			},
+		},
+		"Requested page": {
+			query:                "limit=1&cursor=Mw",
+			expectedStatusCode:   200,
+			expectedResultLength: 1,
+			expectedCursor:       "Mg",
+			grpcMock:             &grpc.Mock{},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("ListAll", context.TODO(), commonModel.PageRequest{Limit: 1, Cursor: "Mw"}).
+					Return(&commonModel.ItemPage{Items: []*commonModel.Item{{ID: 2, Type: "story"}}, NextCursor: "Mg"}, nil)
+			},
+		},
+		"Limit is not a number": {
+			query:              "limit=ten",
+			expectedStatusCode: 400,
+			grpcMock:           &grpc.Mock{},
+		},
+		"Negative limit": {
+			query:              "limit=-1",
+			expectedStatusCode: 400,
+			grpcMock:           &grpc.Mock{},
+		},
+		"Invalid cursor": {
+			query:              "cursor=unknown",
+			expectedStatusCode: 400,
+			grpcMock:           &grpc.Mock{},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("ListAll", context.TODO(), commonModel.PageRequest{Cursor: "unknown"}).
+					Return(nil, fmt.Errorf(`invalid cursor "unknown" %w`, grpc.ErrInvalidArgument))
 			},
 		},
 	}
@@ -51,6 +85,7 @@ func TestGetAll(t *testing.T) {
 				testConfig.expectedMocks(t, testConfig.grpcMock)
 			}
 			rec, eCtx := setupRequest(t, "/all")
+			eCtx.Request().URL.RawQuery = testConfig.query
 			err = handler.GetAll(eCtx)
 			require.NoError(t, err)
 
@@ -62,6 +97,7 @@ func TestGetAll(t *testing.T) {
 				response := decodeRequest(t, rec.Body)
 
 				assert.Equal(t, testConfig.expectedResultLength, len(response.Items))
+				assert.Equal(t, testConfig.expectedCursor, response.NextCursor)
 			}
 		})
 	}
@@ -79,10 +115,10 @@ func TestListStories(t *testing.T) {
 			expectedResultLength: 2,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, cachMock *grpc.Mock) {
-				cachMock.On("ListStories", context.TODO()).Return([]*commonModel.Item{
+				cachMock.On("ListStories", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
 					{ID: 2, Type: "story"},
-				}, nil)
+				}}, nil)
 			},
 		},
 		"Failed to get data": {
@@ -90,7 +126,7 @@ func TestListStories(t *testing.T) {
 			expectedResultLength: 0,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
-				grpcMock.On("ListStories", context.TODO()).Return(nil, errors.New("Failed to find item"))
+				grpcMock.On("ListStories", context.TODO(), commonModel.PageRequest{}).Return(nil, errors.New("Failed to find item"))
 			},
 		},
 	}
@@ -133,10 +169,10 @@ func TestListJobs(t *testing.T) {
 			expectedResultLength: 2,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
-				grpcMock.On("ListJobs", context.TODO()).Return([]*commonModel.Item{
+				grpcMock.On("ListJobs", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "job"},
 					{ID: 2, Type: "job"},
-				}, nil)
+				}}, nil)
 			},
 		},
 		"Failed to get data": {
@@ -144,7 +180,7 @@ func TestListJobs(t *testing.T) {
 			expectedResultLength: 0,
 			grpcMock:             &grpc.Mock{},
 			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
-				grpcMock.On("ListJobs", context.TODO()).Return(nil, errors.New("Failed to find item"))
+				grpcMock.On("ListJobs", context.TODO(), commonModel.PageRequest{}).Return(nil, errors.New("Failed to find item"))
 			},
 		},
 	}
@@ -174,3 +210,209 @@ func TestListJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestSearch(t *testing.T) {
+	tests := map[string]struct {
+		query              string
+		expectedMocks      func(t *testing.T, grpcMock *grpc.Mock)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"Successfully search": {
+			query:              "q=golang+generics&type=story&limit=1",
+			expectedStatusCode: 200,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("Search", context.TODO(), commonModel.SearchRequest{
+					Query:  "golang generics",
+					Limit:  1,
+					Filter: commonModel.ItemFilter{Type: "story"},
+				}).Return(&commonModel.SearchPage{
+					Results: []*commonModel.SearchResult{{
+						Item:      &commonModel.Item{ID: 1, Type: "story", Title: "Golang generics", Text: "<p>Generics are here"},
+						Relevance: 1.5,
+					}},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedBody: `{"next_cursor":"next","results":[{"highlights":{"text":"\u003cmark\u003eGenerics\u003c/mark\u003e are here",` +
+				`"title":"\u003cmark\u003eGolang\u003c/mark\u003e \u003cmark\u003egenerics\u003c/mark\u003e"},` +
+				`"item":{"id":1,"type":"story","text":"\u003cp\u003eGenerics are here","url":"","score":0,"title":"Golang generics",` +
+				`"time":0,"by":"","dead":false,"deleted":false,"feed":"","kids":null,"parent":0,"descendants":0,"poll":0,"parts":null},` +
+				`"relevance":1.5}]}`,
+		},
+		"Missing query": {
+			query:              "type=story",
+			expectedStatusCode: 400,
+		},
+		"Sorted search": {
+			query:              "q=golang&sort=newest",
+			expectedStatusCode: 400,
+		},
+		"Invalid filter param": {
+			query:              "q=golang&min_score=high",
+			expectedStatusCode: 400,
+		},
+		"Rejected by the server": {
+			query:              "q=golang&cursor=unknown",
+			expectedStatusCode: 400,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("Search", context.TODO(), commonModel.SearchRequest{Query: "golang", Cursor: "unknown"}).
+					Return(nil, fmt.Errorf("invalid cursor %w", grpc.ErrInvalidArgument))
+			},
+		},
+		"Failed to search": {
+			query:              "q=golang",
+			expectedStatusCode: 500,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("Search", context.TODO(), commonModel.SearchRequest{Query: "golang"}).
+					Return(nil, errors.New("Failed to search"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcMock := &grpc.Mock{}
+			handler, err := NewHandler(zap.NewNop(), grpcMock)
+			require.NoError(t, err)
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, grpcMock)
+			}
+
+			rec, eCtx := setupRequest(t, "/search")
+			eCtx.Request().URL.RawQuery = testConfig.query
+			err = handler.Search(eCtx)
+			require.NoError(t, err)
+
+			grpcMock.AssertExpectations(t)
+			assert.Equal(t, testConfig.expectedStatusCode, rec.Code)
+			if testConfig.expectedBody != "" {
+				assert.JSONEq(t, testConfig.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetItem(t *testing.T) {
+	tests := map[string]struct {
+		id                 string
+		expectedMocks      func(t *testing.T, grpcMock *grpc.Mock)
+		expectedStatusCode int
+		expectedItem       commonModel.Item
+	}{
+		"Successfully GetItem": {
+			id:                 "1",
+			expectedStatusCode: 200,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Type: "story", Title: "Title"}, nil)
+			},
+			expectedItem: commonModel.Item{ID: 1, Type: "story", Title: "Title"},
+		},
+		"Item not found": {
+			id:                 "2",
+			expectedStatusCode: 404,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetItem", context.TODO(), 2).Return(nil, fmt.Errorf("item 2 %w", grpc.ErrNotFound))
+			},
+		},
+		"Invalid id": {
+			id:                 "abc",
+			expectedStatusCode: 400,
+		},
+		"Failed to get data": {
+			id:                 "3",
+			expectedStatusCode: 500,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetItem", context.TODO(), 3).Return(nil, errors.New("Failed to find item"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcMock := &grpc.Mock{}
+			handler, err := NewHandler(zap.NewNop(), grpcMock)
+			require.NoError(t, err)
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, grpcMock)
+			}
+
+			rec, eCtx := setupRequest(t, "/items/:id")
+			eCtx.SetParamNames("id")
+			eCtx.SetParamValues(testConfig.id)
+			err = handler.GetItem(eCtx)
+			require.NoError(t, err)
+
+			grpcMock.AssertExpectations(t)
+			assert.Equal(t, testConfig.expectedStatusCode, rec.Code)
+			if testConfig.expectedStatusCode == http.StatusOK {
+				var response struct {
+					Item commonModel.Item `json:"item"`
+				}
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+				assert.Equal(t, testConfig.expectedItem, response.Item)
+			}
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	tests := map[string]struct {
+		grpcMock           *grpc.Mock
+		expectedMocks      func(t *testing.T, grpcMock *grpc.Mock)
+		expectedStatusCode int
+		expectedUser       commonModel.User
+	}{
+		"Successfully GetUser": {
+			expectedStatusCode: 200,
+			grpcMock:           &grpc.Mock{},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetUser", context.TODO(), "jl").Return(&commonModel.User{
+					ID: "jl", Karma: 10, About: "Test", Created: 1173923446, Submitted: []int{1},
+				}, nil)
+			},
+			expectedUser: commonModel.User{ID: "jl", Karma: 10, About: "Test", Created: 1173923446, Submitted: []int{1}},
+		},
+		"User not found": {
+			expectedStatusCode: 404,
+			grpcMock:           &grpc.Mock{},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetUser", context.TODO(), "jl").Return(nil, fmt.Errorf("user jl %w", grpc.ErrNotFound))
+			},
+		},
+		"Failed to get data": {
+			expectedStatusCode: 500,
+			grpcMock:           &grpc.Mock{},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock) {
+				grpcMock.On("GetUser", context.TODO(), "jl").Return(nil, errors.New("Failed to find user"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			logger, err := zap.NewProduction()
+			require.NoError(t, err)
+			handler, err := NewHandler(logger, testConfig.grpcMock)
+			require.NoError(t, err)
+
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, testConfig.grpcMock)
+			}
+			rec, eCtx := setupRequest(t, "/users/:id")
+			eCtx.SetParamNames("id")
+			eCtx.SetParamValues("jl")
+			err = handler.GetUser(eCtx)
+			require.NoError(t, err)
+
+			if testConfig.expectedMocks != nil {
+				testConfig.grpcMock.AssertExpectations(t)
+			}
+			assert.Equal(t, testConfig.expectedStatusCode, rec.Code)
+			if testConfig.expectedStatusCode == http.StatusOK {
+				var response struct {
+					User commonModel.User `json:"user"`
+				}
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+				assert.Equal(t, testConfig.expectedUser, response.User)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+)
+
+var sortOrders = map[string]model.SortOrder{
+	"newest": model.SortNewest,
+	"oldest": model.SortOldest,
+	"score":  model.SortTopScore,
+}
+
+// parsePageRequest reads the page, filter and sort order of a list from the query params. since and until are either
+// RFC 3339 times or durations before now, such as 24h
+func parsePageRequest(query url.Values, now time.Time) (model.PageRequest, error) {
+	page := model.PageRequest{
+		Cursor: query.Get("cursor"),
+		Filter: model.ItemFilter{
+			Type:   query.Get("type"),
+			Author: query.Get("author"),
+			Domain: query.Get("domain"),
+		},
+	}
+
+	var err error
+	if page.Limit, err = parseInt(query, "limit"); err != nil {
+		return model.PageRequest{}, err
+	}
+	if page.Limit < 0 {
+		return model.PageRequest{}, fmt.Errorf("Query param limit must be zero or more, got %d", page.Limit)
+	}
+	if sort := query.Get("sort"); sort != "" {
+		var ok bool
+		if page.Sort, ok = sortOrders[sort]; !ok {
+			return model.PageRequest{}, fmt.Errorf("Query param sort must be one of newest, oldest or score, got %q", sort)
+		}
+	}
+
+	filter := &page.Filter
+	if filter.MinScore, err = parseOptionalInt(query, "min_score"); err != nil {
+		return model.PageRequest{}, err
+	}
+	if filter.MaxScore, err = parseOptionalInt(query, "max_score"); err != nil {
+		return model.PageRequest{}, err
+	}
+	if filter.CreatedAfter, err = parseTime(query, "since", now); err != nil {
+		return model.PageRequest{}, err
+	}
+	if filter.CreatedBefore, err = parseTime(query, "until", now); err != nil {
+		return model.PageRequest{}, err
+	}
+	if filter.ExcludeDead, err = parseBool(query, "exclude_dead"); err != nil {
+		return model.PageRequest{}, err
+	}
+	if filter.ExcludeDeleted, err = parseBool(query, "exclude_deleted"); err != nil {
+		return model.PageRequest{}, err
+	}
+	return page, nil
+}
+
+func parseInt(query url.Values, name string) (int, error) {
+	value, err := parseOptionalInt(query, name)
+	if err != nil || value == nil {
+		return 0, err
+	}
+	return *value, nil
+}
+
+func parseOptionalInt(query url.Values, name string) (*int, error) {
+	param := query.Get(name)
+	if param == "" {
+		return nil, nil
+	}
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return nil, fmt.Errorf("Query param %s must be a number, got %q", name, param)
+	}
+	return &value, nil
+}
+
+func parseBool(query url.Values, name string) (bool, error) {
+	param := query.Get(name)
+	if param == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(param)
+	if err != nil {
+		return false, fmt.Errorf("Query param %s must be true or false, got %q", name, param)
+	}
+	return value, nil
+}
+
+// parseTime returns the time as unix seconds, or zero when the param is not set
+func parseTime(query url.Values, name string, now time.Time) (int64, error) {
+	param := query.Get(name)
+	if param == "" {
+		return 0, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, param); err == nil {
+		return parsed.Unix(), nil
+	}
+	ago, err := time.ParseDuration(param)
+	if err != nil || ago <= 0 {
+		return 0, fmt.Errorf("Query param %s must be an RFC 3339 time or a duration before now such as 24h, got %q", name, param)
+	}
+	return now.Add(-ago).Unix(), nil
+}
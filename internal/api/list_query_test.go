@@ -0,0 +1,96 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	now := time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)
+	score := func(value int) *int {
+		return &value
+	}
+	tests := map[string]struct {
+		query        string
+		expectedPage model.PageRequest
+		expectedErr  string
+	}{
+		"No query params": {
+			query:        "",
+			expectedPage: model.PageRequest{},
+		},
+		"Page": {
+			query:        "limit=20&cursor=Mw",
+			expectedPage: model.PageRequest{Limit: 20, Cursor: "Mw"},
+		},
+		"Every filter": {
+			query: "type=story&author=pg&min_score=100&max_score=500&since=24h&until=2022-01-02T00:00:00Z" +
+				"&domain=example.com&exclude_dead=true&exclude_deleted=1&sort=score",
+			expectedPage: model.PageRequest{
+				Filter: model.ItemFilter{
+					Type:           "story",
+					Author:         "pg",
+					MinScore:       score(100),
+					MaxScore:       score(500),
+					CreatedAfter:   now.Add(-24 * time.Hour).Unix(),
+					CreatedBefore:  time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC).Unix(),
+					Domain:         "example.com",
+					ExcludeDead:    true,
+					ExcludeDeleted: true,
+				},
+				Sort: model.SortTopScore,
+			},
+		},
+		"Zero minimum score": {
+			query:        "min_score=0",
+			expectedPage: model.PageRequest{Filter: model.ItemFilter{MinScore: score(0)}},
+		},
+		"Limit is not a number": {
+			query:       "limit=ten",
+			expectedErr: `Query param limit must be a number, got "ten"`,
+		},
+		"Negative limit": {
+			query:       "limit=-1",
+			expectedErr: "Query param limit must be zero or more, got -1",
+		},
+		"Score is not a number": {
+			query:       "max_score=high",
+			expectedErr: `Query param max_score must be a number, got "high"`,
+		},
+		"Unsupported sort order": {
+			query:       "sort=comments",
+			expectedErr: `Query param sort must be one of newest, oldest or score, got "comments"`,
+		},
+		"Invalid time": {
+			query:       "since=yesterday",
+			expectedErr: `Query param since must be an RFC 3339 time or a duration before now such as 24h, got "yesterday"`,
+		},
+		"Negative duration": {
+			query:       "until=-1h",
+			expectedErr: `Query param until must be an RFC 3339 time or a duration before now such as 24h, got "-1h"`,
+		},
+		"Invalid bool": {
+			query:       "exclude_dead=maybe",
+			expectedErr: `Query param exclude_dead must be true or false, got "maybe"`,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			query, err := url.ParseQuery(testConfig.query)
+			require.NoError(t, err)
+
+			page, err := parsePageRequest(query, now)
+			if testConfig.expectedErr != "" {
+				assert.EqualError(t, err, testConfig.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedPage, page)
+		})
+	}
+}
@@ -37,6 +37,9 @@ func NewServer(logger *zap.Logger, client grpc.Client) (*server, error) {
 	router.GET("/all", handler.GetAll)
 	router.GET("/stories", handler.ListStories)
 	router.GET("/jobs", handler.ListJobs)
+	router.GET("/search", handler.Search)
+	router.GET("/items/:id", handler.GetItem)
+	router.GET("/users/:id", handler.GetUser)
 	return &server{
 		logger: logger,
 		router: router,
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// snippetLength is the number of characters of text shown around the first match of a search
+	snippetLength = 200
+	// snippetLead is the number of characters of text shown before the first match of a search
+	snippetLead = 60
+)
+
+var (
+	htmlTags   = regexp.MustCompile(`<[^>]*>`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// searchTerms returns the words of a search query to highlight, leaving out the words the query excludes
+func searchTerms(query string) []string {
+	var terms []string
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if strings.HasPrefix(word, "-") {
+			continue
+		}
+		for _, term := range strings.FieldsFunc(word, isSeparator) {
+			terms = append(terms, stem(term))
+		}
+	}
+	return terms
+}
+
+// stem trims common suffixes from a search term, so that a search for "releases" highlights "released" as the stemmed
+// text search matches it
+func stem(term string) string {
+	for _, suffix := range []string{"ing", "ed", "es", "s"} {
+		trimmed := strings.TrimSuffix(term, suffix)
+		if trimmed != term && utf8.RuneCountInString(trimmed) >= 3 {
+			return trimmed
+		}
+	}
+	return term
+}
+
+// highlight escapes the HTML of the text, wrapping each word that starts with one of the terms in a mark element
+func highlight(text string, terms []string) string {
+	var builder strings.Builder
+	start := 0
+	for start < len(text) {
+		end := start
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if isSeparator(r) {
+				break
+			}
+			end += size
+		}
+		if end == start {
+			// Separators are copied over one at a time
+			_, size := utf8.DecodeRuneInString(text[start:])
+			builder.WriteString(html.EscapeString(text[start : start+size]))
+			start += size
+			continue
+		}
+
+		word := text[start:end]
+		if matchesTerm(word, terms) {
+			builder.WriteString("<mark>" + html.EscapeString(word) + "</mark>")
+		} else {
+			builder.WriteString(html.EscapeString(word))
+		}
+		start = end
+	}
+	return builder.String()
+}
+
+// snippet returns the part of the text around the first word that matches one of the terms, highlighting each match.
+// The text is stored as HTML, so its tags are removed first
+func snippet(text string, terms []string) string {
+	text = strings.TrimSpace(whitespace.ReplaceAllString(html.UnescapeString(htmlTags.ReplaceAllString(text, " ")), " "))
+	runes := []rune(text)
+	if len(runes) <= snippetLength {
+		return highlight(text, terms)
+	}
+
+	first := firstMatch(text, terms)
+	start := first - snippetLead
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLength
+	if end > len(runes) {
+		end = len(runes)
+		start = end - snippetLength
+	}
+	// The snippet is widened to whole words so that no word is cut in two
+	for start > 0 && !unicode.IsSpace(runes[start-1]) {
+		start--
+	}
+	for end < len(runes) && !unicode.IsSpace(runes[end]) {
+		end++
+	}
+
+	result := highlight(string(runes[start:end]), terms)
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(runes) {
+		result += "…"
+	}
+	return result
+}
+
+// firstMatch returns the rune offset of the first word of the text that matches one of the terms, or zero if none do
+func firstMatch(text string, terms []string) int {
+	offset := 0
+	inWord := false
+	wordStart := 0
+	var word strings.Builder
+	for _, r := range text + " " {
+		if isSeparator(r) {
+			if inWord && matchesTerm(word.String(), terms) {
+				return wordStart
+			}
+			inWord = false
+			word.Reset()
+		} else {
+			if !inWord {
+				inWord = true
+				wordStart = offset
+			}
+			word.WriteRune(r)
+		}
+		offset++
+	}
+	return 0
+}
+
+func matchesTerm(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
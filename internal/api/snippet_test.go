@@ -0,0 +1,81 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := map[string]struct {
+		query    string
+		expected []string
+	}{
+		"Words":          {query: "Go Generics", expected: []string{"go", "generic"}},
+		"Excluded words": {query: "rust -kernel", expected: []string{"rust"}},
+		"Phrase":         {query: `"memory safety"`, expected: []string{"memory", "safety"}},
+		"Stemmed words":  {query: "releases running", expected: []string{"releas", "runn"}},
+		"Short words":    {query: "uses", expected: []string{"use"}},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, searchTerms(testConfig.query))
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := map[string]struct {
+		text     string
+		terms    []string
+		expected string
+	}{
+		"No matches":            {text: "Rust in the kernel", terms: []string{"go"}, expected: "Rust in the kernel"},
+		"Matches any case":      {text: "Go generics, finally", terms: []string{"generic"}, expected: "Go <mark>generics</mark>, finally"},
+		"Matches word prefixes": {text: "go gopher ago", terms: []string{"go"}, expected: "<mark>go</mark> <mark>gopher</mark> ago"},
+		"Escapes HTML":          {text: "<b>Go</b> & more", terms: []string{"go"}, expected: "&lt;b&gt;<mark>Go</mark>&lt;/b&gt; &amp; more"},
+		"Unicode words":         {text: "Café crème", terms: []string{"crè"}, expected: "Café <mark>crème</mark>"},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, highlight(testConfig.text, testConfig.terms))
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	long := strings.Repeat("filler words ", 30)
+	tests := map[string]struct {
+		text     string
+		terms    []string
+		expected string
+	}{
+		"Short text is kept whole": {
+			text:     "<p>Go generics &amp; more</p>",
+			terms:    []string{"generic"},
+			expected: "Go <mark>generics</mark> &amp; more",
+		},
+		"Long text around the match": {
+			text:  long + "then golang appears " + long,
+			terms: []string{"golang"},
+			expected: "…words filler words filler words filler words filler words then <mark>golang</mark> appears " +
+				strings.Repeat("filler words ", 9) + "filler words…",
+		},
+		"Long text without a match starts at the beginning": {
+			text:     long,
+			terms:    []string{"golang"},
+			expected: strings.TrimSpace(strings.Repeat("filler words ", 15)) + " filler…",
+		},
+		"Empty text": {
+			text:     "",
+			terms:    []string{"golang"},
+			expected: "",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, snippet(testConfig.text, testConfig.terms))
+		})
+	}
+}
@@ -2,7 +2,11 @@ package caching
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"strconv"
 	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/database"
@@ -13,9 +17,11 @@ import (
 )
 
 type Client interface {
-	ListAll(ctx context.Context) ([]*commonModel.Item, error)
-	ListStories(ctx context.Context) ([]*commonModel.Item, error)
-	ListJobs(ctx context.Context) ([]*commonModel.Item, error)
+	GetItem(ctx context.Context, id int) (*commonModel.Item, error)
+	ListAll(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	ListStories(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	ListJobs(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	EvictItems(ctx context.Context, ids ...int) error
 	Close()
 	FlushAll(ctx context.Context)
 }
@@ -67,36 +73,85 @@ func New(ctx context.Context, redisAddr string, db database.Client, logger *zap.
 	return item, nil
 }
 
-func (c *itemCache) ListAll(ctx context.Context) ([]*commonModel.Item, error) {
-	key := "items:all"
-	return c.cacheItem(key, func(*cache.Item) (interface{}, error) {
+// GetItem returns a single item, caching it under its id. Items that are not found are not cached
+func (c *itemCache) GetItem(ctx context.Context, id int) (*commonModel.Item, error) {
+	key := itemKey(id)
+	var item commonModel.Item
+	err := c.cacheClient.Once(&cache.Item{
+		Key:   key,
+		Value: &item,
+		TTL:   c.ttl,
+		Do: func(*cache.Item) (interface{}, error) {
+			c.logger.Info(fmt.Sprintf("%s caching missed. fetching from source", key))
+			return c.dbClient.GetItem(ctx, id)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// EvictItems removes the cached copy of each item, so that the next read fetches the saved item from the database.
+// Cached pages are left to expire
+func (c *itemCache) EvictItems(ctx context.Context, ids ...int) error {
+	for _, id := range ids {
+		err := c.cacheClient.Delete(ctx, itemKey(id))
+		if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+			return fmt.Errorf("Unable to evict item %d. %w", id, err)
+		}
+	}
+	return nil
+}
+
+func itemKey(id int) string {
+	return fmt.Sprintf("items:%d", id)
+}
+
+func (c *itemCache) ListAll(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	key := pageKey("items:all", page)
+	return c.cachePage(key, func(*cache.Item) (interface{}, error) {
 		c.logger.Info(fmt.Sprintf("%s caching missed. fetching from source", key))
-		return c.dbClient.ListAll(ctx)
+		return c.dbClient.ListAll(ctx, page)
 	})
 }
 
-func (c *itemCache) ListStories(ctx context.Context) ([]*commonModel.Item, error) {
-	key := "items:stories"
-	return c.cacheItem(key, func(*cache.Item) (interface{}, error) {
+func (c *itemCache) ListStories(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	key := pageKey("items:stories", page)
+	return c.cachePage(key, func(*cache.Item) (interface{}, error) {
 		c.logger.Info(fmt.Sprintf("%s caching missed. fetching from source", key))
-		return c.dbClient.ListStories(ctx)
+		return c.dbClient.ListStories(ctx, page)
 	})
 }
 
-func (c *itemCache) ListJobs(ctx context.Context) ([]*commonModel.Item, error) {
-	key := "items:jobs"
-	return c.cacheItem(key, func(*cache.Item) (interface{}, error) {
+func (c *itemCache) ListJobs(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	key := pageKey("items:jobs", page)
+	return c.cachePage(key, func(*cache.Item) (interface{}, error) {
 		c.logger.Info(fmt.Sprintf("%s caching missed. fetching from source", key))
-		return c.dbClient.ListJobs(ctx)
+		return c.dbClient.ListJobs(ctx, page)
 	})
 }
 
-func (c *itemCache) cacheItem(cacheName string, doFunc func(*cache.Item) (interface{}, error)) ([]*commonModel.Item, error) {
-	var items []*commonModel.Item
+// pageKey caches each page separately, keyed by its size, the cursor it starts from, its sort order and a hash of its
+// filter
+func pageKey(prefix string, page commonModel.PageRequest) string {
+	return fmt.Sprintf("%s:%d:%s:%s:%s", prefix, page.Limit, page.Cursor, page.Sort, filterHash(page.Filter))
+}
+
+func filterHash(filter commonModel.ItemFilter) string {
+	// The fields of the filter are always encoded in the same order, so equal filters have the same hash
+	encoded, _ := json.Marshal(filter)
+	hash := fnv.New64a()
+	_, _ = hash.Write(encoded)
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
+func (c *itemCache) cachePage(cacheName string, doFunc func(*cache.Item) (interface{}, error)) (*commonModel.ItemPage, error) {
+	var page commonModel.ItemPage
 
 	err := c.cacheClient.Once(&cache.Item{
 		Key:   cacheName,
-		Value: &items,
+		Value: &page,
 		TTL:   c.ttl,
 		Do:    doFunc,
 	})
@@ -104,7 +159,7 @@ func (c *itemCache) cacheItem(cacheName string, doFunc func(*cache.Item) (interf
 		return nil, err
 	}
 
-	return items, nil
+	return &page, nil
 }
 
 func (c *itemCache) Close() {
@@ -27,20 +27,20 @@ func TestListAll(t *testing.T) {
 			fromCache:          true,
 			expectedItemsCount: 2,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListAll", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListAll", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
 					{ID: 2, Type: "job"},
-				}, nil).Once()
+				}}, nil).Once()
 			},
 		},
 		"From database": {
 			dbMock:             &database.Mock{},
 			expectedItemsCount: 2,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListAll", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListAll", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
 					{ID: 2, Type: "job"},
-				}, nil).Times(2)
+				}}, nil).Times(2)
 			},
 		},
 	}
@@ -56,18 +56,18 @@ func TestListAll(t *testing.T) {
 			}
 
 			// Prepopulate cache
-			items, err := cacheClient.ListAll(context.TODO())
+			page, err := cacheClient.ListAll(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if !testConfig.fromCache {
 				// Clear the cache if test pulling from the db
 				cacheClient.FlushAll(context.TODO())
 			}
 
-			items, err = cacheClient.ListAll(context.TODO())
+			page, err = cacheClient.ListAll(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if testConfig.expectedMocks != nil {
 				testConfig.dbMock.AssertExpectations(t)
@@ -94,19 +94,19 @@ func TestListStories(t *testing.T) {
 			fromCache:          true,
 			expectedItemsCount: 2,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListStories", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListStories", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
 					{ID: 2, Type: "story"},
-				}, nil).Once()
+				}}, nil).Once()
 			},
 		},
 		"From database": {
 			dbMock:             &database.Mock{},
 			expectedItemsCount: 1,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListStories", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListStories", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "story"},
-				}, nil).Times(2)
+				}}, nil).Times(2)
 			},
 		},
 	}
@@ -122,18 +122,18 @@ func TestListStories(t *testing.T) {
 			}
 
 			// Prepopulate cache
-			items, err := cacheClient.ListStories(context.TODO())
+			page, err := cacheClient.ListStories(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if !testConfig.fromCache {
 				// Clear the cache if test pulling from the db
 				cacheClient.FlushAll(context.TODO())
 			}
 
-			items, err = cacheClient.ListStories(context.TODO())
+			page, err = cacheClient.ListStories(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if testConfig.expectedMocks != nil {
 				testConfig.dbMock.AssertExpectations(t)
@@ -160,21 +160,21 @@ func TestListJobs(t *testing.T) {
 			fromCache:          true,
 			expectedItemsCount: 2,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListJobs", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListJobs", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "jobs"},
 					{ID: 2, Type: "jobs"},
-				}, nil).Once()
+				}}, nil).Once()
 			},
 		},
 		"From database": {
 			dbMock:             &database.Mock{},
 			expectedItemsCount: 3,
 			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
-				dbMock.On("ListJobs", context.TODO()).Return([]*commonModel.Item{
+				dbMock.On("ListJobs", context.TODO(), commonModel.PageRequest{}).Return(&commonModel.ItemPage{Items: []*commonModel.Item{
 					{ID: 1, Type: "jobs"},
 					{ID: 2, Type: "jobs"},
 					{ID: 3, Type: "jobs"},
-				}, nil).Times(2)
+				}}, nil).Times(2)
 			},
 		},
 	}
@@ -190,18 +190,18 @@ func TestListJobs(t *testing.T) {
 			}
 
 			// Prepopulate cache
-			items, err := cacheClient.ListJobs(context.TODO())
+			page, err := cacheClient.ListJobs(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if !testConfig.fromCache {
 				// Clear the cache if test pulling from the db
 				cacheClient.FlushAll(context.TODO())
 			}
 
-			items, err = cacheClient.ListJobs(context.TODO())
+			page, err = cacheClient.ListJobs(context.TODO(), commonModel.PageRequest{})
 			require.NoError(t, err)
-			assert.Equal(t, testConfig.expectedItemsCount, len(items))
+			assert.Equal(t, testConfig.expectedItemsCount, len(page.Items))
 
 			if testConfig.expectedMocks != nil {
 				testConfig.dbMock.AssertExpectations(t)
@@ -213,3 +213,147 @@ func TestListJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestListPages(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	dbMock := &database.Mock{}
+	cacheClient, err := New(context.TODO(), redisServer.Addr(), dbMock, zap.NewNop(), WithTTL(time.Minute))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		cacheClient.FlushAll(context.TODO())
+		cacheClient.Close()
+	})
+
+	firstPage := commonModel.PageRequest{Limit: 1}
+	secondPage := commonModel.PageRequest{Limit: 1, Cursor: "Mg"}
+	dbMock.On("ListAll", context.TODO(), firstPage).
+		Return(&commonModel.ItemPage{Items: []*commonModel.Item{{ID: 2}}, NextCursor: "Mg"}, nil).Once()
+	dbMock.On("ListAll", context.TODO(), secondPage).
+		Return(&commonModel.ItemPage{Items: []*commonModel.Item{{ID: 1}}}, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		page, err := cacheClient.ListAll(context.TODO(), firstPage)
+		require.NoError(t, err)
+		assert.Equal(t, &commonModel.ItemPage{Items: []*commonModel.Item{{ID: 2}}, NextCursor: "Mg"}, page)
+
+		page, err = cacheClient.ListAll(context.TODO(), secondPage)
+		require.NoError(t, err)
+		assert.Equal(t, &commonModel.ItemPage{Items: []*commonModel.Item{{ID: 1}}}, page)
+	}
+	dbMock.AssertExpectations(t)
+}
+
+func TestListFilteredPages(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	dbMock := &database.Mock{}
+	cacheClient, err := New(context.TODO(), redisServer.Addr(), dbMock, zap.NewNop(), WithTTL(time.Minute))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		cacheClient.FlushAll(context.TODO())
+		cacheClient.Close()
+	})
+
+	minScore := 100
+	unfiltered := commonModel.PageRequest{}
+	byAuthor := commonModel.PageRequest{Filter: commonModel.ItemFilter{Author: "pg"}}
+	byScore := commonModel.PageRequest{Filter: commonModel.ItemFilter{MinScore: &minScore}, Sort: commonModel.SortTopScore}
+	for i, page := range []commonModel.PageRequest{unfiltered, byAuthor, byScore} {
+		dbMock.On("ListStories", context.TODO(), page).
+			Return(&commonModel.ItemPage{Items: []*commonModel.Item{{ID: i}}}, nil).Once()
+	}
+
+	for i := 0; i < 2; i++ {
+		for expectedID, page := range []commonModel.PageRequest{unfiltered, byAuthor, byScore} {
+			itemPage, err := cacheClient.ListStories(context.TODO(), page)
+			require.NoError(t, err)
+			assert.Equal(t, expectedID, itemPage.Items[0].ID)
+		}
+	}
+	dbMock.AssertExpectations(t)
+}
+
+func TestGetItem(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	tests := map[string]struct {
+		expectedMocks func(t *testing.T, dbMock *database.Mock)
+		fromCache     bool
+		expectedItem  *commonModel.Item
+		expectedErr   error
+	}{
+		"From cache": {
+			fromCache: true,
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Type: "story"}, nil).Once()
+			},
+			expectedItem: &commonModel.Item{ID: 1, Type: "story"},
+		},
+		"From database": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Type: "story"}, nil).Times(2)
+			},
+			expectedItem: &commonModel.Item{ID: 1, Type: "story"},
+		},
+		"Not found is not cached": {
+			fromCache: true,
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetItem", context.TODO(), 1).Return(nil, database.ErrItemNotFound).Times(2)
+			},
+			expectedErr: database.ErrItemNotFound,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dbMock := &database.Mock{}
+			cacheClient, err := New(context.TODO(), redisServer.Addr(), dbMock, zap.NewNop(), WithTTL(time.Minute))
+			require.NoError(t, err)
+			testConfig.expectedMocks(t, dbMock)
+			t.Cleanup(func() {
+				cacheClient.FlushAll(context.TODO())
+				cacheClient.Close()
+			})
+
+			for i := 0; i < 2; i++ {
+				item, err := cacheClient.GetItem(context.TODO(), 1)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, testConfig.expectedItem, item)
+				}
+				if !testConfig.fromCache {
+					cacheClient.FlushAll(context.TODO())
+				}
+			}
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEvictItems(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	dbMock := &database.Mock{}
+	cacheClient, err := New(context.TODO(), redisServer.Addr(), dbMock, zap.NewNop(), WithTTL(time.Minute))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		cacheClient.FlushAll(context.TODO())
+		cacheClient.Close()
+	})
+	dbMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Title: "Before"}, nil).Once()
+	dbMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Title: "After"}, nil).Once()
+
+	item, err := cacheClient.GetItem(context.TODO(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Before", item.Title)
+
+	// Item 2 was never cached, which is not an error
+	require.NoError(t, cacheClient.EvictItems(context.TODO(), 1, 2))
+
+	item, err = cacheClient.GetItem(context.TODO(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "After", item.Title, "The saved item should be read once its cached copy is evicted")
+	dbMock.AssertExpectations(t)
+}
@@ -11,28 +11,43 @@ type Mock struct {
 	mock.Mock
 }
 
-func (m *Mock) ListAll(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) GetItem(ctx context.Context, id int) (*model.Item, error) {
+	args := m.Called(ctx, id)
+
+	item, ok := args.Get(0).(*model.Item)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return item, args.Error(1)
+}
+
+func (m *Mock) ListAll(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
+}
+
+func (m *Mock) ListStories(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
 }
 
-func (m *Mock) ListStories(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) ListJobs(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
 }
 
-func (m *Mock) ListJobs(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) EvictItems(ctx context.Context, ids ...int) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
 }
 
-func find(args mock.Arguments) ([]*model.Item, error) {
-	collection, ok := args.Get(0).([]*model.Item)
+func findPage(args mock.Arguments) (*model.ItemPage, error) {
+	page, ok := args.Get(0).(*model.ItemPage)
 	if !ok {
 		return nil, args.Error(1)
 	}
 
-	return collection, args.Error(1)
+	return page, args.Error(1)
 }
 
 func (m *Mock) Close() {
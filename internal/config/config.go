@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	"github.com/mitchellh/mapstructure"
@@ -49,7 +50,28 @@ func bindEnvs(v *viper.Viper, config model.Configuration) error {
 
 func setDefaults(v *viper.Viper) {
 	v.SetDefault("cron", "*/15 * * * *")
+	v.SetDefault("feeds", []string{"top"})
+	v.SetDefault("incremental_sync", true)
+	v.SetDefault("publisher_workers", 10)
+	v.SetDefault("publisher_run_timeout", 10*time.Minute)
+	v.SetDefault("hn_request_timeout", 10*time.Second)
+	v.SetDefault("hn_max_retries", 3)
+	v.SetDefault("hn_rate_limit", 50)
+	v.SetDefault("hn_rate_burst", 10)
+	v.SetDefault("hn_max_in_flight", 20)
+	v.SetDefault("backfill_workers", 10)
+	v.SetDefault("backfill_batch_size", 100)
 	v.SetDefault("workers", 5)
+	v.SetDefault("consumer_drain_timeout", 30*time.Second)
+	v.SetDefault("consumer_batch_size", 20)
+	v.SetDefault("consumer_batch_window", 500*time.Millisecond)
+	v.SetDefault("queue_backend", "rabbitmq")
+	v.SetDefault("rabbitmq_exchange", "hackernews")
+	v.SetDefault("rabbitmq_binding_keys", []string{"#"})
+	v.SetDefault("rabbitmq_max_retries", 5)
+	v.SetDefault("rabbitmq_retry_delay", 30*time.Second)
+	v.SetDefault("rabbitmq_confirm_timeout", 5*time.Second)
+	v.SetDefault("rabbitmq_prefetch", 100)
 
 	v.SetDefault("api_address", ":8080")
 	v.SetDefault("grpc_port", 9000)
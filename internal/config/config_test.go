@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	"github.com/stretchr/testify/assert"
@@ -30,11 +31,34 @@ func TestLoadConfig(t *testing.T) {
 			envVars: envVars,
 			expected: &model.Configuration{
 				Publisher: model.PublisherConfig{
-					BaseUrl:      "localhost:8000",
-					CronSchedule: "*/15 * * * *",
+					BaseUrl:           "localhost:8000",
+					CronSchedule:      "*/15 * * * *",
+					Feeds:             []string{"top"},
+					IncrementalSync:   true,
+					Workers:           10,
+					RunTimeout:        10 * time.Minute,
+					RequestTimeout:    10 * time.Second,
+					MaxRetries:        3,
+					RateLimit:         50,
+					RateBurst:         10,
+					MaxInFlight:       20,
+					BackfillWorkers:   10,
+					BackfillBatchSize: 100,
 				},
 				Consumer: model.ConsumerConfig{
 					NumberOfWorkers: 5,
+					DrainTimeout:    30 * time.Second,
+					BatchSize:       20,
+					BatchWindow:     500 * time.Millisecond,
+				},
+				RabbitMq: model.RabbitMqConfig{
+					Backend:        "rabbitmq",
+					Exchange:       "hackernews",
+					BindingKeys:    []string{"#"},
+					MaxRetries:     5,
+					RetryDelay:     30 * time.Second,
+					ConfirmTimeout: 5 * time.Second,
+					Prefetch:       100,
 				},
 				Database: model.DatabaseConfig{
 					Username: "test_username",
@@ -49,16 +73,83 @@ func TestLoadConfig(t *testing.T) {
 				Grpc: model.GrpcServerConfig{Port: 9000},
 			},
 		},
+		"Successfully load feeds from environmental variables": {
+			envVars: env{
+				"BASE_URL":              "localhost:8000",
+				"FEEDS":                 "top,new,job",
+				"PUBLISHER_WORKERS":     "3",
+				"PUBLISHER_RUN_TIMEOUT": "90s",
+			},
+			expected: &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl:           "localhost:8000",
+					CronSchedule:      "*/15 * * * *",
+					Feeds:             []string{"top", "new", "job"},
+					IncrementalSync:   true,
+					Workers:           3,
+					RunTimeout:        90 * time.Second,
+					RequestTimeout:    10 * time.Second,
+					MaxRetries:        3,
+					RateLimit:         50,
+					RateBurst:         10,
+					MaxInFlight:       20,
+					BackfillWorkers:   10,
+					BackfillBatchSize: 100,
+				},
+				Consumer: model.ConsumerConfig{
+					NumberOfWorkers: 5,
+					DrainTimeout:    30 * time.Second,
+					BatchSize:       20,
+					BatchWindow:     500 * time.Millisecond,
+				},
+				RabbitMq: model.RabbitMqConfig{
+					Backend:        "rabbitmq",
+					Exchange:       "hackernews",
+					BindingKeys:    []string{"#"},
+					MaxRetries:     5,
+					RetryDelay:     30 * time.Second,
+					ConfirmTimeout: 5 * time.Second,
+					Prefetch:       100,
+				},
+				Api: model.APIConfig{
+					Address: ":8080",
+				},
+				Grpc: model.GrpcServerConfig{Port: 9000},
+			},
+		},
 		"Successfully load config from file": {
 			filePath:  ".",
 			writeFile: true,
 			expected: &model.Configuration{
 				Publisher: model.PublisherConfig{
-					BaseUrl:      "localhost:8000",
-					CronSchedule: "*/15 * * * *",
+					BaseUrl:           "localhost:8000",
+					CronSchedule:      "*/15 * * * *",
+					Feeds:             []string{"top"},
+					IncrementalSync:   true,
+					Workers:           10,
+					RunTimeout:        10 * time.Minute,
+					RequestTimeout:    10 * time.Second,
+					MaxRetries:        3,
+					RateLimit:         50,
+					RateBurst:         10,
+					MaxInFlight:       20,
+					BackfillWorkers:   10,
+					BackfillBatchSize: 100,
 				},
 				Consumer: model.ConsumerConfig{
 					NumberOfWorkers: 5,
+					DrainTimeout:    30 * time.Second,
+					BatchSize:       20,
+					BatchWindow:     500 * time.Millisecond,
+				},
+				RabbitMq: model.RabbitMqConfig{
+					Backend:        "rabbitmq",
+					Exchange:       "hackernews",
+					BindingKeys:    []string{"#"},
+					MaxRetries:     5,
+					RetryDelay:     30 * time.Second,
+					ConfirmTimeout: 5 * time.Second,
+					Prefetch:       100,
 				},
 				Api: model.APIConfig{
 					Address: ":8080",
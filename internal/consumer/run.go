@@ -0,0 +1,76 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/grpc"
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	"go.uber.org/zap"
+)
+
+// Run saves the messages received from the queue through the GRPC server until the queue client stops delivering
+// them, then gives the workers up to the drain timeout to save and acknowledge the messages already delivered
+func Run(logger *zap.Logger, config *model.Configuration, qClient queue.Client) error {
+	grpcClient, err := grpc.NewClient(config.GrpcClient.GrpcAddress, logger)
+	if err != nil {
+		return fmt.Errorf("Unable to create GRPC client. %w", err)
+	}
+	defer grpcClient.Close()
+	logger.Info("GRPC client connected to server")
+	wg := sync.WaitGroup{}
+	msgChan := make(chan *queue.Message)
+
+	// The workers are not stopped by the interrupt so that the messages in flight can be saved and acknowledged
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+	consumerClient := New(logger, grpcClient, WithBatching(config.Consumer.BatchSize, config.Consumer.BatchWindow))
+	for i := 0; i < config.Consumer.NumberOfWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			consumerClient.ProcessMessages(workCtx, msgChan)
+		}()
+	}
+
+	err = qClient.ReceiveMessage(msgChan)
+	close(msgChan)
+	if err != nil {
+		return fmt.Errorf("Unable to consume messages from the queue. %w", err)
+	}
+
+	logger.Info("Draining in-flight messages", zap.Duration("timeout", config.Consumer.DrainTimeout))
+	if !waitForWorkers(&wg, config.Consumer.DrainTimeout) {
+		// Messages that are still unacknowledged are requeued by RabbitMQ once the connection is closed
+		logger.Warn("Timed out draining in-flight messages")
+		return nil
+	}
+	logger.Info("Drained in-flight messages")
+	return nil
+}
+
+// waitForWorkers waits up to timeout for the workers to finish, returning false if they did not. A timeout of zero
+// waits until they finish
+func waitForWorkers(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	if timeout <= 0 {
+		<-done
+		return true
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
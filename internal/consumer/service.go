@@ -2,33 +2,158 @@ package consumer
 
 import (
 	"context"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/grpc"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
 	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"go.uber.org/zap"
 )
 
 type service struct {
-	logger     *zap.Logger
-	grpcClient grpc.Client
+	logger      *zap.Logger
+	grpcClient  grpc.Client
+	batchSize   int
+	batchWindow time.Duration
 }
 
 type Service interface {
-	ProcessMessages(ctx context.Context, itemChan <-chan *commonModel.Item)
+	ProcessMessages(ctx context.Context, msgChan <-chan *queue.Message)
 }
 
-func New(logger *zap.Logger, grpcClient grpc.Client) *service {
-	return &service{
+type ServiceOptions func(*service)
+
+// WithBatching saves items in batches of up to size items, saving a partial batch once window has passed since its
+// first item was received. Items are saved one at a time when size is 1 or less
+func WithBatching(size int, window time.Duration) ServiceOptions {
+	return func(s *service) {
+		s.batchSize = size
+		s.batchWindow = window
+	}
+}
+
+func New(logger *zap.Logger, grpcClient grpc.Client, opts ...ServiceOptions) *service {
+	s := &service{
 		logger:     logger,
 		grpcClient: grpcClient,
+		batchSize:  1,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
-func (s *service) ProcessMessages(ctx context.Context, itemChan <-chan *commonModel.Item) {
-	for item := range itemChan {
-		err := s.grpcClient.SaveItem(ctx, item)
+// ProcessMessages saves each message it receives, acknowledging it once saved. Messages that fail to save are
+// retried after a delay until they reach the max retries, after which they are dead-lettered. When batching is enabled,
+// items are saved in batches while users are still saved as they are received
+func (s *service) ProcessMessages(ctx context.Context, msgChan <-chan *queue.Message) {
+	if s.batchSize <= 1 {
+		for msg := range msgChan {
+			s.processMessage(ctx, msg)
+		}
+		return
+	}
+
+	batch := make([]*queue.Message, 0, s.batchSize)
+	var window <-chan time.Time
+	for {
+		select {
+		case msg, ok := <-msgChan:
+			if !ok {
+				// The channel is closed on shutdown, so the partial batch is saved before returning
+				s.saveBatch(ctx, batch)
+				return
+			}
+			if msg.Item == nil {
+				s.processMessage(ctx, msg)
+				continue
+			}
+			batch = append(batch, msg)
+			if len(batch) == 1 {
+				window = time.After(s.batchWindow)
+			}
+			if len(batch) < s.batchSize {
+				continue
+			}
+		case <-window:
+		}
+
+		s.saveBatch(ctx, batch)
+		batch = batch[:0]
+		window = nil
+	}
+}
+
+func (s *service) processMessage(ctx context.Context, msg *queue.Message) {
+	var err error
+	switch {
+	case msg.Item != nil:
+		err = s.grpcClient.SaveItem(ctx, msg.Item)
 		if err != nil {
-			s.logger.Error("Failed to save item", zap.Int("id", item.ID), zap.Error(err))
+			s.logger.Error("Failed to save item", zap.Int("id", msg.Item.ID), zap.String("message_id", msg.ID), zap.Error(err))
+		}
+	case msg.User != nil:
+		err = s.grpcClient.SaveUser(ctx, msg.User)
+		if err != nil {
+			s.logger.Error("Failed to save user", zap.String("id", msg.User.ID), zap.String("message_id", msg.ID), zap.Error(err))
+		}
+	default:
+		s.logger.Error("Dead-lettering message without an item or user")
+		if err := msg.Nack(false); err != nil {
+			s.logger.Error("Unable to reject message", zap.Error(err))
+		}
+		return
+	}
+
+	if err != nil {
+		s.retry(msg)
+		return
+	}
+	s.ack(msg)
+}
+
+// saveBatch saves the items of the batch in one call, acknowledging each message whose item was saved and retrying the
+// rest. Every message is retried if the call fails as a whole
+func (s *service) saveBatch(ctx context.Context, batch []*queue.Message) {
+	if len(batch) == 0 {
+		return
+	}
+	items := make([]*commonModel.Item, len(batch))
+	for i, msg := range batch {
+		items[i] = msg.Item
+	}
+
+	itemErrs, err := s.grpcClient.SaveItems(ctx, items)
+	if err != nil {
+		s.logger.Error("Failed to save batch of items", zap.Int("count", len(batch)), zap.Error(err))
+		for _, msg := range batch {
+			s.retry(msg)
+		}
+		return
+	}
+	for i, msg := range batch {
+		if itemErrs[i] != nil {
+			s.logger.Error("Failed to save item", zap.Int("id", msg.Item.ID), zap.String("message_id", msg.ID), zap.Error(itemErrs[i]))
+			s.retry(msg)
+			continue
+		}
+		s.ack(msg)
+	}
+}
+
+func (s *service) ack(msg *queue.Message) {
+	if err := msg.Ack(); err != nil {
+		s.logger.Error("Unable to acknowledge message", zap.Error(err))
+	}
+}
+
+// retry schedules the message to be retried, requeueing it straight away if it cannot be
+func (s *service) retry(msg *queue.Message) {
+	if err := msg.Retry(); err != nil {
+		s.logger.Error("Unable to retry message, requeueing it", zap.Error(err))
+		if err := msg.Nack(true); err != nil {
+			s.logger.Error("Unable to requeue message", zap.Error(err))
 		}
 	}
 }
@@ -0,0 +1,212 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/grpc"
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestProcessMessages(t *testing.T) {
+	tests := map[string]struct {
+		item          *commonModel.Item
+		user          *commonModel.User
+		expectedMocks func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message)
+	}{
+		"Item acknowledged once saved": {
+			item: &commonModel.Item{ID: 1},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveItem", context.TODO(), &commonModel.Item{ID: 1}).Return(nil).Once()
+				queueMock.On("Ack", msg).Return(nil).Once()
+			},
+		},
+		"User acknowledged once saved": {
+			user: &commonModel.User{ID: "jl"},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveUser", context.TODO(), &commonModel.User{ID: "jl"}).Return(nil).Once()
+				queueMock.On("Ack", msg).Return(nil).Once()
+			},
+		},
+		"Item retried when it fails to save": {
+			item: &commonModel.Item{ID: 1},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveItem", context.TODO(), &commonModel.Item{ID: 1}).Return(errors.New("Failed")).Once()
+				queueMock.On("Retry", msg).Return(nil).Once()
+			},
+		},
+		"User retried when it fails to save": {
+			user: &commonModel.User{ID: "jl"},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveUser", context.TODO(), &commonModel.User{ID: "jl"}).Return(errors.New("Failed")).Once()
+				queueMock.On("Retry", msg).Return(nil).Once()
+			},
+		},
+		"Requeued when it cannot be retried": {
+			item: &commonModel.Item{ID: 1},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveItem", context.TODO(), &commonModel.Item{ID: 1}).Return(errors.New("Failed")).Once()
+				queueMock.On("Retry", msg).Return(errors.New("Channel closed")).Once()
+				queueMock.On("Nack", msg, true).Return(nil).Once()
+			},
+		},
+		"Empty message dead-lettered": {
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				queueMock.On("Nack", msg, false).Return(nil).Once()
+			},
+		},
+		"Failed acknowledgement does not stop processing": {
+			item: &commonModel.Item{ID: 1},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msg *queue.Message) {
+				grpcMock.On("SaveItem", context.TODO(), &commonModel.Item{ID: 1}).Return(nil).Once()
+				queueMock.On("Ack", msg).Return(errors.New("Channel closed")).Once()
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcMock, queueMock := &grpc.Mock{}, &queue.Mock{}
+			msg := queueMock.NewMessage(testConfig.item, testConfig.user)
+			testConfig.expectedMocks(t, grpcMock, queueMock, msg)
+
+			msgChan := make(chan *queue.Message, 1)
+			msgChan <- msg
+			close(msgChan)
+			New(zap.NewNop(), grpcMock).ProcessMessages(context.TODO(), msgChan)
+
+			grpcMock.AssertExpectations(t)
+			queueMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessMessagesBatched(t *testing.T) {
+	items := []*commonModel.Item{{ID: 1}, {ID: 2}}
+	tests := map[string]struct {
+		batchSize     int
+		user          *commonModel.User
+		expectedMocks func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message)
+	}{
+		"Full batch acknowledged once saved": {
+			batchSize: 2,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message) {
+				grpcMock.On("SaveItems", context.TODO(), items).Return([]error{nil, nil}, nil).Once()
+				queueMock.On("Ack", msgs[0]).Return(nil).Once()
+				queueMock.On("Ack", msgs[1]).Return(nil).Once()
+			},
+		},
+		"Partial batch saved when the channel is closed": {
+			batchSize: 10,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message) {
+				grpcMock.On("SaveItems", context.TODO(), items).Return([]error{nil, nil}, nil).Once()
+				queueMock.On("Ack", msgs[0]).Return(nil).Once()
+				queueMock.On("Ack", msgs[1]).Return(nil).Once()
+			},
+		},
+		"Only the items that failed to save are retried": {
+			batchSize: 2,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message) {
+				grpcMock.On("SaveItems", context.TODO(), items).Return([]error{nil, errors.New("Failed")}, nil).Once()
+				queueMock.On("Ack", msgs[0]).Return(nil).Once()
+				queueMock.On("Retry", msgs[1]).Return(nil).Once()
+			},
+		},
+		"Every item retried when the batch fails to save": {
+			batchSize: 2,
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message) {
+				grpcMock.On("SaveItems", context.TODO(), items).Return(nil, errors.New("Failed")).Once()
+				queueMock.On("Retry", msgs[0]).Return(nil).Once()
+				queueMock.On("Retry", msgs[1]).Return(nil).Once()
+			},
+		},
+		"Users saved as they are received": {
+			batchSize: 2,
+			user:      &commonModel.User{ID: "jl"},
+			expectedMocks: func(t *testing.T, grpcMock *grpc.Mock, queueMock *queue.Mock, msgs []*queue.Message) {
+				grpcMock.On("SaveUser", context.TODO(), &commonModel.User{ID: "jl"}).Return(nil).Once()
+				grpcMock.On("SaveItems", context.TODO(), items).Return([]error{nil, nil}, nil).Once()
+				queueMock.On("Ack", msgs[0]).Return(nil).Once()
+				queueMock.On("Ack", msgs[1]).Return(nil).Once()
+				queueMock.On("Ack", msgs[2]).Return(nil).Once()
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcMock, queueMock := &grpc.Mock{}, &queue.Mock{}
+			msgs := []*queue.Message{queueMock.NewMessage(items[0], nil), queueMock.NewMessage(items[1], nil)}
+			if testConfig.user != nil {
+				msgs = append(msgs, queueMock.NewMessage(nil, testConfig.user))
+			}
+			testConfig.expectedMocks(t, grpcMock, queueMock, msgs)
+
+			msgChan := make(chan *queue.Message, len(msgs))
+			for _, msg := range msgs {
+				msgChan <- msg
+			}
+			close(msgChan)
+			New(zap.NewNop(), grpcMock, WithBatching(testConfig.batchSize, time.Minute)).ProcessMessages(context.TODO(), msgChan)
+
+			grpcMock.AssertExpectations(t)
+			queueMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessMessagesBatchWindow(t *testing.T) {
+	grpcMock, queueMock := &grpc.Mock{}, &queue.Mock{}
+	msg := queueMock.NewMessage(&commonModel.Item{ID: 1}, nil)
+	grpcMock.On("SaveItems", context.TODO(), []*commonModel.Item{{ID: 1}}).Return([]error{nil}, nil).Once()
+	acked := make(chan struct{})
+	queueMock.On("Ack", msg).Return(nil).Once().Run(func(args mock.Arguments) { close(acked) })
+
+	msgChan := make(chan *queue.Message)
+	defer close(msgChan)
+	go New(zap.NewNop(), grpcMock, WithBatching(10, 10*time.Millisecond)).ProcessMessages(context.TODO(), msgChan)
+	msgChan <- msg
+
+	select {
+	case <-acked:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for the partial batch to be saved")
+	}
+	grpcMock.AssertExpectations(t)
+}
+
+func TestProcessMessagesInMemory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	config := &model.RabbitMqConfig{QueueName: "consumer-test", MaxRetries: 1, RetryDelay: time.Millisecond}
+	publisher := queue.NewMemory(zap.NewNop(), ctx, config)
+	consumerQueue := queue.NewMemory(zap.NewNop(), ctx, config)
+
+	saved := make(chan struct{})
+	grpcMock := new(grpc.Mock)
+	grpcMock.On("SaveItem", ctx, &commonModel.Item{ID: 1, Type: "story", Feed: "top"}).Return(errors.New("Failed")).Once()
+	grpcMock.On("SaveItem", ctx, &commonModel.Item{ID: 1, Type: "story", Feed: "top"}).Return(nil).Once().
+		Run(func(args mock.Arguments) { close(saved) })
+
+	msgChan := make(chan *queue.Message)
+	go New(zap.NewNop(), grpcMock).ProcessMessages(ctx, msgChan)
+	go consumerQueue.ReceiveMessage(msgChan)
+
+	require.NoError(t, publisher.SendMessage(commonModel.Item{ID: 1, Type: "story", Feed: "top"}))
+	select {
+	case <-saved:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for the item to be saved")
+	}
+	grpcMock.AssertExpectations(t)
+
+	deadLetters, err := consumerQueue.ListDeadLetters(0)
+	require.NoError(t, err)
+	assert.Empty(t, deadLetters)
+}
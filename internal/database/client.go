@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,12 +17,37 @@ import (
 
 type Client interface {
 	SaveItem(ctx context.Context, item *commonModel.Item) error
-	ListAll(ctx context.Context) ([]*commonModel.Item, error)
-	ListStories(ctx context.Context) ([]*commonModel.Item, error)
-	ListJobs(ctx context.Context) ([]*commonModel.Item, error)
+	SaveItems(ctx context.Context, items []*commonModel.Item) ([]error, error)
+	GetItem(ctx context.Context, id int) (*commonModel.Item, error)
+	ListAll(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	ListStories(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	ListJobs(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error)
+	Search(ctx context.Context, request commonModel.SearchRequest) (*commonModel.SearchPage, error)
+	SaveUser(ctx context.Context, user *commonModel.User) error
+	GetUser(ctx context.Context, id string) (*commonModel.User, error)
+	GetCheckpoint(ctx context.Context, name string) (int, error)
+	SaveCheckpoint(ctx context.Context, name string, value int) error
+	Migrate(ctx context.Context) ([]MigrationStatus, error)
+	Migrations(ctx context.Context) ([]MigrationStatus, error)
 	CloseConnection(ctx context.Context)
 }
 
+var (
+	// ErrItemNotFound is returned when the requested item has not been stored
+	ErrItemNotFound = errors.New("item not found")
+	// ErrUserNotFound is returned when the requested user has not been stored
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidCursor is returned when a list is requested with a cursor that was not returned by a previous page
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidFilter is returned when a list is requested with a filter or sort order that cannot be applied
+	ErrInvalidFilter = errors.New("invalid filter")
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
 type database struct {
 	mongoClient  *mongo.Client
 	logger       *zap.Logger
@@ -80,23 +106,146 @@ func (d *database) SaveItem(ctx context.Context, item *commonModel.Item) error {
 	return nil
 }
 
-func (d *database) ListAll(ctx context.Context) ([]*commonModel.Item, error) {
-	return d.find(ctx, bson.M{})
+// SaveItems upserts the items in a single bulk write. An error is returned when the write fails as a whole, otherwise
+// the returned slice holds the error of each item that could not be saved, in the same position as the item
+func (d *database) SaveItems(ctx context.Context, items []*commonModel.Item) ([]error, error) {
+	itemErrs := make([]error, len(items))
+	if len(items) == 0 {
+		return itemErrs, nil
+	}
+
+	writes := make([]mongo.WriteModel, len(items))
+	for i, item := range items {
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"id": item.ID}).
+			SetUpdate(bson.M{"$set": item}).
+			SetUpsert(true)
+	}
+	// Unordered writes carry on past a failed item, so one bad item does not fail the rest of the batch
+	opts := options.BulkWrite().SetOrdered(false)
+	_, err := d.getCollection("items").BulkWrite(ctx, writes, opts)
+	if err != nil {
+		var bulkErr mongo.BulkWriteException
+		if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+			return nil, fmt.Errorf("Unable to save items. %w", err)
+		}
+		for _, writeErr := range bulkErr.WriteErrors {
+			itemErrs[writeErr.Index] = fmt.Errorf("Unable to save item %d. %w", items[writeErr.Index].ID, writeErr)
+		}
+		d.logger.Warn("Some items failed to save", zap.Int("count", len(items)), zap.Int("failed", len(bulkErr.WriteErrors)))
+		return itemErrs, nil
+	}
+	d.logger.Info("Items saved successfully", zap.Int("count", len(items)))
+	return itemErrs, nil
 }
 
-func (d *database) ListStories(ctx context.Context) ([]*commonModel.Item, error) {
-	filter := bson.M{"type": "story"}
-	return d.find(ctx, filter)
+func (d *database) GetItem(ctx context.Context, id int) (*commonModel.Item, error) {
+	var item commonModel.Item
+	err := d.getCollection("items").FindOne(ctx, bson.M{"id": id}).Decode(&item)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("Failed to retrieve item. %w", err)
+	}
+	return &item, nil
+}
+
+// ListAll returns a page of the items matching the filter
+func (d *database) ListAll(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	return d.findPage(ctx, page)
+}
+
+// ListStories returns a page of the stories matching the filter, whatever type it filters on
+func (d *database) ListStories(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	page.Filter.Type = "story"
+	return d.findPage(ctx, page)
+}
+
+// ListJobs returns a page of the jobs matching the filter, whatever type it filters on
+func (d *database) ListJobs(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	page.Filter.Type = "job"
+	return d.findPage(ctx, page)
+}
+
+// findPage returns the requested page of items. The cursor holds the sort values of the last item of the previous
+// page, so each page is read from an index rather than by skipping the previous pages
+func (d *database) findPage(ctx context.Context, page commonModel.PageRequest) (*commonModel.ItemPage, error) {
+	limit := pageLimit(page.Limit)
+	filter, err := itemFilter(page.Filter)
+	if err != nil {
+		return nil, err
+	}
+	order, err := newSortOrder(page.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if page.Cursor != "" {
+		after, err := order.after(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter = bson.M{"$and": []bson.M{filter, after}}
+	}
+
+	// One extra item is read to tell whether there is another page
+	opts := options.Find().SetSort(order.sort()).SetLimit(int64(limit + 1))
+	items, err := d.find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	itemPage := &commonModel.ItemPage{Items: items}
+	if len(items) > limit {
+		itemPage.Items = items[:limit]
+		itemPage.NextCursor = order.cursor(items[limit-1])
+	}
+	return itemPage, nil
+}
+
+// Search returns a page of the items matching the filter whose title or text match the query, most relevant first
+func (d *database) Search(ctx context.Context, request commonModel.SearchRequest) (*commonModel.SearchPage, error) {
+	limit := pageLimit(request.Limit)
+	pipeline, err := searchPipeline(request, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := d.getCollection("items")
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to search items. %w", err)
+	}
+	var documents []searchDocument
+	if err = cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("Failed to retrieve search results within cursor. %w", err)
+	}
+
+	results := make([]*commonModel.SearchResult, len(documents))
+	for i := range documents {
+		results[i] = &commonModel.SearchResult{Item: &documents[i].Item, Relevance: documents[i].Relevance}
+	}
+	page := &commonModel.SearchPage{Results: results}
+	if len(results) > limit {
+		page.Results = results[:limit]
+		page.NextCursor = searchCursor(results[limit-1])
+	}
+	return page, nil
 }
 
-func (d *database) ListJobs(ctx context.Context) ([]*commonModel.Item, error) {
-	filter := bson.M{"type": "job"}
-	return d.find(ctx, filter)
+// pageLimit returns the number of items to return in a page, using the default page size when no limit is given
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return limit
 }
 
-func (d *database) find(ctx context.Context, filter interface{}) ([]*commonModel.Item, error) {
+func (d *database) find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) ([]*commonModel.Item, error) {
 	collection := d.getCollection("items")
-	all, err := collection.Find(ctx, filter)
+	all, err := collection.Find(ctx, filter, opts...)
 	var items []*commonModel.Item
 	if err != nil {
 		return nil, fmt.Errorf("Failed to retrieve items. %w", err)
@@ -108,6 +257,84 @@ func (d *database) find(ctx context.Context, filter interface{}) ([]*commonModel
 	return items, nil
 }
 
+func (d *database) SaveUser(ctx context.Context, user *commonModel.User) error {
+	collection := d.getCollection("users")
+	opts := options.Update().SetUpsert(true)
+
+	update := bson.M{
+		"$set": user,
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"id": user.ID}, update, opts)
+	if err != nil {
+		return fmt.Errorf("Unable to save user. %w", err)
+	}
+	d.logger.Info("User saved successfully", zap.String("ID", user.ID))
+	return nil
+}
+
+// GetUser retrieves a stored user profile with the submitted ids restricted to the items that have been stored
+func (d *database) GetUser(ctx context.Context, id string) (*commonModel.User, error) {
+	var user commonModel.User
+	err := d.getCollection("users").FindOne(ctx, bson.M{"id": id}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("Failed to retrieve user. %w", err)
+	}
+	if len(user.Submitted) == 0 {
+		return &user, nil
+	}
+
+	submitted, err := d.find(ctx, bson.M{"id": bson.M{"$in": user.Submitted}}, options.Find().SetProjection(bson.M{"id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve submitted items. %w", err)
+	}
+	stored := make(map[int]struct{}, len(submitted))
+	for _, item := range submitted {
+		stored[item.ID] = struct{}{}
+	}
+	// The stored items come back in no particular order, so the user's own order, newest first, is kept
+	storedSubmitted := make([]int, 0, len(stored))
+	for _, id := range user.Submitted {
+		if _, ok := stored[id]; ok {
+			storedSubmitted = append(storedSubmitted, id)
+		}
+	}
+	user.Submitted = storedSubmitted
+	return &user, nil
+}
+
+// GetCheckpoint returns the stored value for the named checkpoint, or zero if it has never been saved
+func (d *database) GetCheckpoint(ctx context.Context, name string) (int, error) {
+	var checkpoint struct {
+		Value int `bson:"value"`
+	}
+	err := d.getCollection("checkpoints").FindOne(ctx, bson.M{"name": name}).Decode(&checkpoint)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("Failed to retrieve checkpoint. %w", err)
+	}
+	return checkpoint.Value, nil
+}
+
+func (d *database) SaveCheckpoint(ctx context.Context, name string, value int) error {
+	collection := d.getCollection("checkpoints")
+	opts := options.Update().SetUpsert(true)
+
+	update := bson.M{
+		"$set": bson.M{"name": name, "value": value},
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"name": name}, update, opts)
+	if err != nil {
+		return fmt.Errorf("Unable to save checkpoint. %w", err)
+	}
+	d.logger.Debug("Checkpoint saved successfully", zap.String("name", name), zap.Int("value", value))
+	return nil
+}
+
 func (d *database) CloseConnection(ctx context.Context) {
 	d.logger.Debug("Closing database connection")
 	err := d.mongoClient.Disconnect(ctx)
@@ -93,6 +93,59 @@ func TestSaveItem(t *testing.T) {
 	}
 }
 
+func TestSaveItems(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, mongo)
+	defer mongo.Terminate(context.TODO())
+	config := &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "test",
+	}
+	tests := map[string]struct {
+		items         []*commonModel.Item
+		expectedItems int
+	}{
+		"Nothing to save": {
+			items: []*commonModel.Item{},
+		},
+		"New items saved": {
+			items:         []*commonModel.Item{{ID: 1, Type: "story"}, {ID: 2, Type: "job"}},
+			expectedItems: 2,
+		},
+		"Existing items updated": {
+			items:         []*commonModel.Item{{ID: 1, Type: "story", Score: 10}, {ID: 3, Type: "story"}},
+			expectedItems: 3,
+		},
+	}
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.CloseConnection(context.TODO())
+	})
+
+	for _, testName := range []string{"Nothing to save", "New items saved", "Existing items updated"} {
+		testConfig := tests[testName]
+		t.Run(testName, func(t *testing.T) {
+			itemErrs, err := client.SaveItems(context.TODO(), testConfig.items)
+			require.NoError(t, err)
+			assert.Len(t, itemErrs, len(testConfig.items))
+			for _, itemErr := range itemErrs {
+				assert.NoError(t, itemErr)
+			}
+
+			page, err := client.ListAll(context.TODO(), commonModel.PageRequest{})
+			require.NoError(t, err)
+			assert.Len(t, page.Items, testConfig.expectedItems)
+		})
+	}
+}
+
 func TestListAll(t *testing.T) {
 	mongo, dbConfig, err := setupMongo(context.TODO())
 	require.NoError(t, err)
@@ -164,7 +217,7 @@ func TestListAll(t *testing.T) {
 				Name:     "test",
 			},
 			expectedResponse: []*commonModel.Item{
-				&item1, &item2,
+				&item2, &item1,
 			},
 			itemsToSave: []*commonModel.Item{
 				&item1, &item2,
@@ -187,14 +240,15 @@ func TestListAll(t *testing.T) {
 				}
 			}
 
-			items, err := client.ListAll(context.TODO())
+			page, err := client.ListAll(context.TODO(), commonModel.PageRequest{})
 			if testConfig.expectedErr != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErr, "Request failed should be: %v, got: %v", testConfig.expectedErr, err)
-				assert.Nil(t, items)
+				assert.Nil(t, page)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(testConfig.expectedResponse), len(items))
-				assert.Equal(t, testConfig.expectedResponse, items)
+				assert.Equal(t, len(testConfig.expectedResponse), len(page.Items))
+				assert.Equal(t, testConfig.expectedResponse, page.Items)
+				assert.Empty(t, page.NextCursor)
 			}
 			t.Cleanup(func() {
 				client.CloseConnection(context.TODO())
@@ -203,6 +257,222 @@ func TestListAll(t *testing.T) {
 	}
 }
 
+func TestListPages(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	defer mongo.Terminate(context.TODO())
+
+	config := &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "test",
+	}
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.CloseConnection(context.TODO())
+	})
+	dropDatabase(dbConfig, config.Name)
+	for id := 1; id <= 5; id++ {
+		require.NoError(t, client.SaveItem(context.TODO(), &commonModel.Item{ID: id, Type: "story"}))
+	}
+
+	var ids []int
+	page := commonModel.PageRequest{Limit: 2}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3, "Expected the items to be listed in three pages")
+		itemPage, err := client.ListAll(context.TODO(), page)
+		require.NoError(t, err)
+		for _, item := range itemPage.Items {
+			ids = append(ids, item.ID)
+		}
+		if itemPage.NextCursor == "" {
+			break
+		}
+		page.Cursor = itemPage.NextCursor
+	}
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, ids)
+
+	_, err = client.ListAll(context.TODO(), commonModel.PageRequest{Cursor: "not a cursor"})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestListFiltered(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	defer mongo.Terminate(context.TODO())
+
+	config := &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "test",
+	}
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.CloseConnection(context.TODO())
+	})
+	dropDatabase(dbConfig, config.Name)
+	items := []*commonModel.Item{
+		{ID: 1, Type: "story", CreatedBy: "pg", Score: 150, Time: 1000, URL: "https://example.com/a"},
+		{ID: 2, Type: "story", CreatedBy: "pg", Score: 50, Time: 2000, URL: "https://blog.example.com/b"},
+		{ID: 3, Type: "story", CreatedBy: "dang", Score: 300, Time: 3000, URL: "https://other.org/c"},
+		{ID: 4, Type: "job", CreatedBy: "pg", Score: 150, Time: 4000, Dead: true},
+		{ID: 5, Type: "story", CreatedBy: "pg", Score: 150, Time: 5000, Deleted: true},
+	}
+	for _, item := range items {
+		require.NoError(t, client.SaveItem(context.TODO(), item))
+	}
+	minScore := 100
+
+	tests := map[string]struct {
+		page        commonModel.PageRequest
+		expectedIDs []int
+		expectedErr error
+	}{
+		"By author": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{Author: "pg"}},
+			expectedIDs: []int{5, 4, 2, 1},
+		},
+		"Minimum score sorted by score": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{MinScore: &minScore}, Sort: commonModel.SortTopScore},
+			expectedIDs: []int{3, 5, 4, 1},
+		},
+		"Time range, oldest first": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{CreatedAfter: 2000, CreatedBefore: 5000}, Sort: commonModel.SortOldest},
+			expectedIDs: []int{2, 3, 4},
+		},
+		"Domain": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{Domain: "example.com"}},
+			expectedIDs: []int{2, 1},
+		},
+		"Excluding dead and deleted items": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{ExcludeDead: true, ExcludeDeleted: true}},
+			expectedIDs: []int{3, 2, 1},
+		},
+		"Pages sorted by score": {
+			page:        commonModel.PageRequest{Limit: 2, Sort: commonModel.SortTopScore},
+			expectedIDs: []int{3, 5, 4, 1, 2},
+		},
+		"Invalid filter": {
+			page:        commonModel.PageRequest{Filter: commonModel.ItemFilter{CreatedAfter: 2, CreatedBefore: 1}},
+			expectedErr: ErrInvalidFilter,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var ids []int
+			page := testConfig.page
+			for {
+				itemPage, err := client.ListAll(context.TODO(), page)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+				for _, item := range itemPage.Items {
+					ids = append(ids, item.ID)
+				}
+				if itemPage.NextCursor == "" {
+					break
+				}
+				page.Cursor = itemPage.NextCursor
+			}
+			assert.Equal(t, testConfig.expectedIDs, ids)
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	defer mongo.Terminate(context.TODO())
+
+	config := &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "test",
+	}
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	dropDatabase(dbConfig, config.Name)
+	client, err := New(context.TODO(), logger, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.CloseConnection(context.TODO())
+	})
+	_, err = client.Migrate(context.TODO())
+	require.NoError(t, err, "Search needs the text index created by the migrations")
+	items := []*commonModel.Item{
+		{ID: 1, Type: "story", Title: "Go generics released"},
+		{ID: 2, Type: "comment", Text: "I have been writing Go with generics for a while"},
+		{ID: 3, Type: "story", Title: "Rust in the kernel"},
+		{ID: 4, Type: "job", Title: "Hiring Go engineers", Text: "We use generics everywhere"},
+	}
+	for _, item := range items {
+		require.NoError(t, client.SaveItem(context.TODO(), item))
+	}
+
+	tests := map[string]struct {
+		request     commonModel.SearchRequest
+		expectedIDs []int
+		expectedErr error
+	}{
+		"Title matches rank above text matches": {
+			request:     commonModel.SearchRequest{Query: "generics"},
+			expectedIDs: []int{1, 4, 2},
+		},
+		"Filtered": {
+			request:     commonModel.SearchRequest{Query: "generics", Filter: commonModel.ItemFilter{Type: "story"}},
+			expectedIDs: []int{1},
+		},
+		"Pages": {
+			request:     commonModel.SearchRequest{Query: "generics", Limit: 1},
+			expectedIDs: []int{1, 4, 2},
+		},
+		"No matches": {
+			request: commonModel.SearchRequest{Query: "python"},
+		},
+		"Empty query": {
+			request:     commonModel.SearchRequest{},
+			expectedErr: ErrInvalidFilter,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var ids []int
+			request := testConfig.request
+			for {
+				page, err := client.Search(context.TODO(), request)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+				for _, result := range page.Results {
+					assert.Greater(t, result.Relevance, 0.0)
+					ids = append(ids, result.Item.ID)
+				}
+				if page.NextCursor == "" {
+					break
+				}
+				request.Cursor = page.NextCursor
+			}
+			assert.Equal(t, testConfig.expectedIDs, ids)
+		})
+	}
+}
+
 func TestListStories(t *testing.T) {
 	mongo, dbConfig, err := setupMongo(context.TODO())
 	require.NoError(t, err)
@@ -269,7 +539,7 @@ func TestListStories(t *testing.T) {
 				Name:     "stories",
 			},
 			expectedResponse: []*commonModel.Item{
-				&story1, &story2,
+				&story2, &story1,
 			},
 			itemsToSave: []*commonModel.Item{
 				&story1, &story2, &job1,
@@ -292,14 +562,15 @@ func TestListStories(t *testing.T) {
 				}
 			}
 
-			items, err := client.ListStories(context.TODO())
+			page, err := client.ListStories(context.TODO(), commonModel.PageRequest{})
 			if testConfig.expectedErr != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErr, "Request failed should be: %v, got: %v", testConfig.expectedErr, err)
-				assert.Nil(t, items)
+				assert.Nil(t, page)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(testConfig.expectedResponse), len(items))
-				assert.Equal(t, testConfig.expectedResponse, items)
+				assert.Equal(t, len(testConfig.expectedResponse), len(page.Items))
+				assert.Equal(t, testConfig.expectedResponse, page.Items)
+				assert.Empty(t, page.NextCursor)
 			}
 			t.Cleanup(func() {
 				client.CloseConnection(context.TODO())
@@ -374,7 +645,7 @@ func TestListJobs(t *testing.T) {
 				Name:     "jobs",
 			},
 			expectedResponse: []*commonModel.Item{
-				&job1, &job2,
+				&job2, &job1,
 			},
 			itemsToSave: []*commonModel.Item{
 				&story1, &job1, &job2,
@@ -397,14 +668,159 @@ func TestListJobs(t *testing.T) {
 				}
 			}
 
-			items, err := client.ListJobs(context.TODO())
+			page, err := client.ListJobs(context.TODO(), commonModel.PageRequest{})
+			if testConfig.expectedErr != "" {
+				assert.EqualErrorf(t, err, testConfig.expectedErr, "Request failed should be: %v, got: %v", testConfig.expectedErr, err)
+				assert.Nil(t, page)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(testConfig.expectedResponse), len(page.Items))
+				assert.Equal(t, testConfig.expectedResponse, page.Items)
+				assert.Empty(t, page.NextCursor)
+			}
+			t.Cleanup(func() {
+				client.CloseConnection(context.TODO())
+			})
+		})
+	}
+}
+
+func TestGetItem(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, mongo)
+	defer mongo.Terminate(context.TODO())
+	config := &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "items",
+	}
+	story := &commonModel.Item{ID: 1, Type: "story", Title: "Title", Kids: []int{2}, Feed: "top"}
+
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.CloseConnection(context.TODO())
+	})
+	require.NoError(t, client.SaveItem(context.TODO(), story))
+	// An update published without a feed keeps the feed the story was saved with
+	require.NoError(t, client.SaveItem(context.TODO(), &commonModel.Item{ID: 1, Type: "story", Title: "Title", Kids: []int{2}}))
+
+	tests := map[string]struct {
+		id           int
+		expectedItem *commonModel.Item
+		expectedErr  string
+	}{
+		"Returns stored item": {id: 1, expectedItem: story},
+		"Item not found":      {id: 2, expectedErr: "item not found"},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			item, err := client.GetItem(context.TODO(), testConfig.id)
+			if testConfig.expectedErr != "" {
+				assert.EqualError(t, err, testConfig.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedItem, item)
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, mongo)
+	require.NotNil(t, dbConfig)
+	defer mongo.Terminate(context.TODO())
+
+	story := commonModel.Item{
+		ID:        1,
+		Type:      "story",
+		CreatedBy: "jl",
+	}
+	comment := commonModel.Item{
+		ID:        3,
+		Type:      "comment",
+		CreatedBy: "jl",
+	}
+	newestStory := commonModel.Item{
+		ID:        5,
+		Type:      "story",
+		CreatedBy: "jl",
+	}
+	// Submissions are listed newest first
+	user := commonModel.User{
+		ID:        "jl",
+		Created:   1173923446,
+		Karma:     2937,
+		About:     "This is a test",
+		Submitted: []int{5, 3, 2, 1},
+	}
+
+	tests := map[string]struct {
+		config           *model.DatabaseConfig
+		userToSave       *commonModel.User
+		itemsToSave      []*commonModel.Item
+		expectedResponse *commonModel.User
+		expectedErr      string
+	}{
+		"User not found": {
+			config: &model.DatabaseConfig{
+				Username: dbConfig.User,
+				Password: dbConfig.Password,
+				Host:     dbConfig.Host,
+				Port:     fmt.Sprint(dbConfig.Port),
+				Name:     "users",
+			},
+			expectedErr: "user not found",
+		},
+		"Returns user with stored submissions": {
+			config: &model.DatabaseConfig{
+				Username: dbConfig.User,
+				Password: dbConfig.Password,
+				Host:     dbConfig.Host,
+				Port:     fmt.Sprint(dbConfig.Port),
+				Name:     "users",
+			},
+			userToSave:  &user,
+			itemsToSave: []*commonModel.Item{&story, &comment, &newestStory},
+			expectedResponse: &commonModel.User{
+				ID:        "jl",
+				Created:   1173923446,
+				Karma:     2937,
+				About:     "This is a test",
+				Submitted: []int{5, 3, 1},
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			logger, err := zap.NewProduction()
+			require.NoError(t, err)
+
+			client, err := New(context.TODO(), logger, testConfig.config)
+			require.NoError(t, err)
+
+			dropDatabase(dbConfig, testConfig.config.Name)
+			if testConfig.userToSave != nil {
+				require.NoError(t, client.SaveUser(context.TODO(), testConfig.userToSave))
+			}
+			for _, item := range testConfig.itemsToSave {
+				require.NoError(t, client.SaveItem(context.TODO(), item))
+			}
+
+			user, err := client.GetUser(context.TODO(), "jl")
 			if testConfig.expectedErr != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErr, "Request failed should be: %v, got: %v", testConfig.expectedErr, err)
-				assert.Nil(t, items)
+				assert.Nil(t, user)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(testConfig.expectedResponse), len(items))
-				assert.Equal(t, testConfig.expectedResponse, items)
+				assert.Equal(t, testConfig.expectedResponse, user)
 			}
 			t.Cleanup(func() {
 				client.CloseConnection(context.TODO())
@@ -413,6 +829,85 @@ func TestListJobs(t *testing.T) {
 	}
 }
 
+func TestCheckpoints(t *testing.T) {
+	mongo, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, mongo)
+	require.NotNil(t, dbConfig)
+	defer mongo.Terminate(context.TODO())
+
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "checkpoints",
+	})
+	require.NoError(t, err)
+	defer client.CloseConnection(context.TODO())
+
+	value, err := client.GetCheckpoint(context.TODO(), "maxitem")
+	require.NoError(t, err)
+	assert.Zero(t, value, "Unsaved checkpoints should default to zero")
+
+	require.NoError(t, client.SaveCheckpoint(context.TODO(), "maxitem", 100))
+	require.NoError(t, client.SaveCheckpoint(context.TODO(), "maxitem", 150))
+
+	value, err = client.GetCheckpoint(context.TODO(), "maxitem")
+	require.NoError(t, err)
+	assert.Equal(t, 150, value)
+}
+
+func TestMigrate(t *testing.T) {
+	container, dbConfig, err := setupMongo(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, container)
+	require.NotNil(t, dbConfig)
+	defer container.Terminate(context.TODO())
+
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+	client, err := New(context.TODO(), logger, &model.DatabaseConfig{
+		Username: dbConfig.User,
+		Password: dbConfig.Password,
+		Host:     dbConfig.Host,
+		Port:     fmt.Sprint(dbConfig.Port),
+		Name:     "migrations",
+	})
+	require.NoError(t, err)
+	defer client.CloseConnection(context.TODO())
+
+	statuses, err := client.Migrations(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, statuses, len(migrations))
+	for _, status := range statuses {
+		assert.Zero(t, status.AppliedAt, "Migration %d should be pending", status.Version)
+	}
+
+	applied, err := client.Migrate(context.TODO())
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrations))
+
+	applied, err = client.Migrate(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, applied, "Applied migrations should not be applied again")
+
+	statuses, err = client.Migrations(context.TODO())
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.NotZero(t, status.AppliedAt, "Migration %d should be applied", status.Version)
+	}
+
+	// The unique index stops an item being inserted twice, which upserts rely on under concurrent writes
+	items := client.getCollection("items")
+	_, err = items.InsertOne(context.TODO(), commonModel.Item{ID: 1})
+	require.NoError(t, err)
+	_, err = items.InsertOne(context.TODO(), commonModel.Item{ID: 1})
+	assert.True(t, mongo.IsDuplicateKeyError(err), "Expected a duplicate key error, got %v", err)
+}
+
 func dropDatabase(config tcMongo.DBConfig, dbName string) {
 	opts := options.Client().ApplyURI(config.ConnectionURI())
 	if strings.TrimSpace(config.User) != "" && strings.TrimSpace(config.Password) != "" {
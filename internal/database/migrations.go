@@ -0,0 +1,170 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// migrationsCollection records each migration that has been applied, keyed by its version
+const migrationsCollection = "schema_migrations"
+
+// Error codes returned by mongo when dropping an index that does not exist
+const (
+	namespaceNotFoundCode = 26
+	indexNotFoundCode     = 27
+)
+
+// MigrationStatus describes a migration and when it was applied, which is zero while it is pending
+type MigrationStatus struct {
+	Version     int       `bson:"_id" json:"version"`
+	Description string    `bson:"description" json:"description"`
+	AppliedAt   time.Time `bson:"applied_at" json:"applied_at"`
+}
+
+// migration is a change to the database that is applied once, in version order. Released migrations must not be
+// edited, instead a migration with the next version is added. Every migration must be safe to apply again, as two
+// servers starting at the same time can both apply it
+type migration struct {
+	version     int
+	description string
+	up          func(ctx context.Context, db *mongo.Database) error
+}
+
+var migrations = []migration{
+	{version: 1, description: "Create a unique item id index and the indexes that lists filter and sort on", up: createItemIndexes},
+	{version: 2, description: "Create the item text index that search reads from", up: createItemTextIndex},
+	{version: 3, description: "Create unique user id and checkpoint name indexes", up: createUserAndCheckpointIndexes},
+}
+
+// Migrate applies the migrations that have not been applied yet in version order, returning those it applied. It stops
+// at the first migration that fails, so that the later migrations can rely on the earlier ones
+func (d *database) Migrate(ctx context.Context) ([]MigrationStatus, error) {
+	statuses, err := d.Migrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := d.mongoClient.Database(d.databaseName)
+	var applied []MigrationStatus
+	for i, m := range migrations {
+		if !statuses[i].AppliedAt.IsZero() {
+			continue
+		}
+		d.logger.Info("Applying migration", zap.Int("version", m.version), zap.String("description", m.description))
+		if err := m.up(ctx, db); err != nil {
+			return applied, fmt.Errorf("Unable to apply migration %d. %w", m.version, err)
+		}
+
+		// Mongo stores times to the millisecond
+		status := MigrationStatus{Version: m.version, Description: m.description, AppliedAt: time.Now().UTC().Truncate(time.Millisecond)}
+		_, err := db.Collection(migrationsCollection).InsertOne(ctx, status)
+		// A duplicate means another server applied the migration at the same time
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return applied, fmt.Errorf("Unable to record migration %d. %w", m.version, err)
+		}
+		applied = append(applied, status)
+	}
+	return applied, nil
+}
+
+// Migrations returns the status of every migration in version order
+func (d *database) Migrations(ctx context.Context) ([]MigrationStatus, error) {
+	cursor, err := d.getCollection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve applied migrations. %w", err)
+	}
+	var recorded []MigrationStatus
+	if err = cursor.All(ctx, &recorded); err != nil {
+		return nil, fmt.Errorf("Failed to retrieve applied migrations within cursor. %w", err)
+	}
+	return migrationStatuses(recorded), nil
+}
+
+// migrationStatuses returns the status of every migration, taking the time each was applied from the recorded
+// migrations
+func migrationStatuses(recorded []MigrationStatus) []MigrationStatus {
+	appliedAt := make(map[int]time.Time, len(recorded))
+	for _, status := range recorded {
+		appliedAt[status.Version] = status.AppliedAt
+	}
+	statuses := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		statuses[i] = MigrationStatus{Version: m.version, Description: m.description, AppliedAt: appliedAt[m.version]}
+	}
+	return statuses
+}
+
+func createItemIndexes(ctx context.Context, db *mongo.Database) error {
+	items := db.Collection("items")
+	// Earlier releases created a non-unique id index, which the unique index replaces
+	if err := dropIndexIfExists(ctx, items, "id_-1"); err != nil {
+		return err
+	}
+	_, err := items.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "type", Value: 1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "time", Value: -1}}},
+		{Keys: bson.D{{Key: "score", Value: -1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "by", Value: 1}, {Key: "id", Value: -1}}},
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("Items with duplicated ids must be removed before the unique id index can be created. %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to create item indexes. %w", err)
+	}
+	return nil
+}
+
+func createItemTextIndex(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("items").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "title", Value: "text"}, {Key: "text", Value: "text"}},
+		Options: options.Index().SetWeights(bson.D{{Key: "title", Value: 3}, {Key: "text", Value: 1}}),
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to create item text index. %w", err)
+	}
+	return nil
+}
+
+func createUserAndCheckpointIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("users").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("Users with duplicated ids must be removed before the unique id index can be created. %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to create user indexes. %w", err)
+	}
+
+	_, err = db.Collection("checkpoints").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to create checkpoint indexes. %w", err)
+	}
+	return nil
+}
+
+// dropIndexIfExists drops the named index, doing nothing when the index or its collection does not exist
+func dropIndexIfExists(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && (commandErr.Code == namespaceNotFoundCode || commandErr.Code == indexNotFoundCode) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to drop index %s. %w", name, err)
+	}
+	return nil
+}
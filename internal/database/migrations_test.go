@@ -0,0 +1,48 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationVersions(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.version, "Migration versions should increase by one from 1")
+		assert.NotEmpty(t, m.description, "Migration %d should have a description", m.version)
+		assert.NotNil(t, m.up, "Migration %d should have an up function", m.version)
+	}
+}
+
+func TestMigrationStatuses(t *testing.T) {
+	appliedAt := time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		recorded        []MigrationStatus
+		expectedApplied map[int]time.Time
+	}{
+		"Nothing applied": {
+			recorded:        nil,
+			expectedApplied: map[int]time.Time{},
+		},
+		"First migration applied": {
+			recorded:        []MigrationStatus{{Version: 1, AppliedAt: appliedAt}},
+			expectedApplied: map[int]time.Time{1: appliedAt},
+		},
+		"Unknown migrations are ignored": {
+			recorded:        []MigrationStatus{{Version: 1, AppliedAt: appliedAt}, {Version: 1000, AppliedAt: appliedAt}},
+			expectedApplied: map[int]time.Time{1: appliedAt},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			statuses := migrationStatuses(testConfig.recorded)
+			assert.Len(t, statuses, len(migrations))
+			for i, status := range statuses {
+				assert.Equal(t, migrations[i].version, status.Version)
+				assert.Equal(t, migrations[i].description, status.Description)
+				assert.Equal(t, testConfig.expectedApplied[status.Version], status.AppliedAt)
+			}
+		})
+	}
+}
@@ -17,19 +17,61 @@ func (m *Mock) SaveItem(ctx context.Context, item *model.Item) error {
 	return args.Error(0)
 }
 
-func (m *Mock) ListAll(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) SaveItems(ctx context.Context, items []*model.Item) ([]error, error) {
+	args := m.Called(ctx, items)
+	itemErrs, _ := args.Get(0).([]error)
+	return itemErrs, args.Error(1)
 }
 
-func (m *Mock) ListStories(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) GetItem(ctx context.Context, id int) (*model.Item, error) {
+	args := m.Called(ctx, id)
+
+	item, ok := args.Get(0).(*model.Item)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return item, args.Error(1)
+}
+
+func (m *Mock) ListAll(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
 }
 
-func (m *Mock) ListJobs(ctx context.Context) ([]*model.Item, error) {
-	args := m.Called(ctx)
-	return find(args)
+func (m *Mock) ListStories(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
+}
+
+func (m *Mock) ListJobs(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	args := m.Called(ctx, page)
+	return findPage(args)
+}
+
+func (m *Mock) SaveUser(ctx context.Context, user *model.User) error {
+	args := m.Called(ctx, user)
+
+	return args.Error(0)
+}
+
+func (m *Mock) GetUser(ctx context.Context, id string) (*model.User, error) {
+	args := m.Called(ctx, id)
+
+	user, ok := args.Get(0).(*model.User)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return user, args.Error(1)
+}
+
+func (m *Mock) GetCheckpoint(ctx context.Context, name string) (int, error) {
+	args := m.Called(ctx, name)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *Mock) SaveCheckpoint(ctx context.Context, name string, value int) error {
+	args := m.Called(ctx, name, value)
+	return args.Error(0)
 }
 
 func find(args mock.Arguments) ([]*model.Item, error) {
@@ -41,6 +83,42 @@ func find(args mock.Arguments) ([]*model.Item, error) {
 	return collection, args.Error(1)
 }
 
+func (m *Mock) Search(ctx context.Context, request model.SearchRequest) (*model.SearchPage, error) {
+	args := m.Called(ctx, request)
+
+	page, ok := args.Get(0).(*model.SearchPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return page, args.Error(1)
+}
+
+func findPage(args mock.Arguments) (*model.ItemPage, error) {
+	page, ok := args.Get(0).(*model.ItemPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return page, args.Error(1)
+}
+
+func (m *Mock) Migrate(ctx context.Context) ([]MigrationStatus, error) {
+	return statuses(m.Called(ctx))
+}
+
+func (m *Mock) Migrations(ctx context.Context) ([]MigrationStatus, error) {
+	return statuses(m.Called(ctx))
+}
+
+func statuses(args mock.Arguments) ([]MigrationStatus, error) {
+	migrationStatuses, ok := args.Get(0).([]MigrationStatus)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return migrationStatuses, args.Error(1)
+}
+
 func (m *Mock) CloseConnection(ctx context.Context) {
 	// Do nothing as this is a mock
 }
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// itemFilter translates the filter into a mongo filter, rejecting filters that can never match an item
+func itemFilter(filter commonModel.ItemFilter) (bson.M, error) {
+	query := bson.M{}
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+	if filter.Author != "" {
+		query["by"] = filter.Author
+	}
+
+	if filter.MinScore != nil && filter.MaxScore != nil && *filter.MinScore > *filter.MaxScore {
+		return nil, fmt.Errorf("Minimum score %d is above the maximum score %d. %w", *filter.MinScore, *filter.MaxScore, ErrInvalidFilter)
+	}
+	score := bson.M{}
+	if filter.MinScore != nil {
+		score["$gte"] = *filter.MinScore
+	}
+	if filter.MaxScore != nil {
+		score["$lte"] = *filter.MaxScore
+	}
+	if len(score) > 0 {
+		query["score"] = score
+	}
+
+	if filter.CreatedAfter != 0 && filter.CreatedBefore != 0 && filter.CreatedAfter >= filter.CreatedBefore {
+		return nil, fmt.Errorf("Created after %d is not before created before %d. %w", filter.CreatedAfter, filter.CreatedBefore, ErrInvalidFilter)
+	}
+	created := bson.M{}
+	if filter.CreatedAfter != 0 {
+		created["$gte"] = filter.CreatedAfter
+	}
+	if filter.CreatedBefore != 0 {
+		created["$lt"] = filter.CreatedBefore
+	}
+	if len(created) > 0 {
+		query["time"] = created
+	}
+
+	if filter.Domain != "" {
+		pattern, err := domainPattern(filter.Domain)
+		if err != nil {
+			return nil, err
+		}
+		query["url"] = primitive.Regex{Pattern: pattern, Options: "i"}
+	}
+	if filter.ExcludeDead {
+		query["dead"] = bson.M{"$ne": true}
+	}
+	if filter.ExcludeDeleted {
+		query["deleted"] = bson.M{"$ne": true}
+	}
+	return query, nil
+}
+
+// domainPattern matches the urls whose host is the domain or one of its subdomains
+func domainPattern(domain string) (string, error) {
+	if strings.ContainsAny(domain, "/:?#@ ") {
+		return "", fmt.Errorf("Domain %q must be a host name. %w", domain, ErrInvalidFilter)
+	}
+	return `^https?://([^/?#@]+\.)?` + regexp.QuoteMeta(domain) + `(:[0-9]+)?([/?#]|$)`, nil
+}
+
+// sortKey is an item field that lists are sorted by
+type sortKey struct {
+	field string
+	value func(item *commonModel.Item) int
+}
+
+var (
+	idKey    = sortKey{field: "id", value: func(item *commonModel.Item) int { return item.ID }}
+	scoreKey = sortKey{field: "score", value: func(item *commonModel.Item) int { return item.Score }}
+)
+
+// sortOrder sorts a list by its keys in turn, all in the same direction. The last key is always the id, so that every
+// item has a distinct position to resume the list from
+type sortOrder struct {
+	name      commonModel.SortOrder
+	keys      []sortKey
+	direction int
+}
+
+var sortOrders = map[commonModel.SortOrder]sortOrder{
+	commonModel.SortNewest:   {name: commonModel.SortNewest, keys: []sortKey{idKey}, direction: -1},
+	commonModel.SortOldest:   {name: commonModel.SortOldest, keys: []sortKey{idKey}, direction: 1},
+	commonModel.SortTopScore: {name: commonModel.SortTopScore, keys: []sortKey{scoreKey, idKey}, direction: -1},
+}
+
+// newSortOrder returns the sort order with the name, listing the newest items first when no name is given
+func newSortOrder(name commonModel.SortOrder) (sortOrder, error) {
+	if name == "" {
+		name = commonModel.SortNewest
+	}
+	order, ok := sortOrders[name]
+	if !ok {
+		return sortOrder{}, fmt.Errorf("Unsupported sort order %q. %w", name, ErrInvalidFilter)
+	}
+	return order, nil
+}
+
+func (o sortOrder) sort() bson.D {
+	sort := make(bson.D, len(o.keys))
+	for i, key := range o.keys {
+		sort[i] = bson.E{Key: key.field, Value: o.direction}
+	}
+	return sort
+}
+
+// cursor encodes the name of the sort order along with the sort values of the item, which is the last of a page
+func (o sortOrder) cursor(item *commonModel.Item) string {
+	parts := []string{string(o.name)}
+	for _, key := range o.keys {
+		parts = append(parts, strconv.Itoa(key.value(item)))
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, ":")))
+}
+
+// after returns a filter matching the items that are sorted after the item the cursor was taken from. Cursors that
+// were taken from a list in another sort order are rejected
+func (o sortOrder) after(cursor string) (bson.M, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decode cursor %q. %w", cursor, ErrInvalidCursor)
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != len(o.keys)+1 || parts[0] != string(o.name) {
+		return nil, fmt.Errorf("Cursor %q is not for the %s sort order. %w", cursor, o.name, ErrInvalidCursor)
+	}
+	values := make([]int, len(o.keys))
+	for i, part := range parts[1:] {
+		if values[i], err = strconv.Atoi(part); err != nil {
+			return nil, fmt.Errorf("Unable to decode cursor %q. %w", cursor, ErrInvalidCursor)
+		}
+	}
+
+	operator := "$gt"
+	if o.direction < 0 {
+		operator = "$lt"
+	}
+	// An item is sorted after the cursor when it matches every earlier key of the cursor and is past it on the next key
+	after := make([]bson.M, len(o.keys))
+	for i, key := range o.keys {
+		filter := bson.M{key.field: bson.M{operator: values[i]}}
+		for j := 0; j < i; j++ {
+			filter[o.keys[j].field] = values[j]
+		}
+		after[i] = filter
+	}
+	if len(after) == 1 {
+		return after[0], nil
+	}
+	return bson.M{"$or": after}, nil
+}
@@ -0,0 +1,162 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestItemFilter(t *testing.T) {
+	score := func(value int) *int {
+		return &value
+	}
+	tests := map[string]struct {
+		filter        commonModel.ItemFilter
+		expectedQuery bson.M
+		expectedErr   string
+	}{
+		"Empty filter matches every item": {
+			filter:        commonModel.ItemFilter{},
+			expectedQuery: bson.M{},
+		},
+		"Type and author": {
+			filter:        commonModel.ItemFilter{Type: "story", Author: "pg"},
+			expectedQuery: bson.M{"type": "story", "by": "pg"},
+		},
+		"Score range": {
+			filter:        commonModel.ItemFilter{MinScore: score(0), MaxScore: score(100)},
+			expectedQuery: bson.M{"score": bson.M{"$gte": 0, "$lte": 100}},
+		},
+		"Minimum score above the maximum": {
+			filter:      commonModel.ItemFilter{MinScore: score(10), MaxScore: score(5)},
+			expectedErr: "Minimum score 10 is above the maximum score 5. invalid filter",
+		},
+		"Time range": {
+			filter:        commonModel.ItemFilter{CreatedAfter: 100, CreatedBefore: 200},
+			expectedQuery: bson.M{"time": bson.M{"$gte": int64(100), "$lt": int64(200)}},
+		},
+		"Created after the end of the time range": {
+			filter:      commonModel.ItemFilter{CreatedAfter: 200, CreatedBefore: 200},
+			expectedErr: "Created after 200 is not before created before 200. invalid filter",
+		},
+		"Domain": {
+			filter: commonModel.ItemFilter{Domain: "example.com"},
+			expectedQuery: bson.M{"url": primitive.Regex{
+				Pattern: `^https?://([^/?#@]+\.)?example\.com(:[0-9]+)?([/?#]|$)`,
+				Options: "i",
+			}},
+		},
+		"Domain with a path": {
+			filter:      commonModel.ItemFilter{Domain: "example.com/news"},
+			expectedErr: `Domain "example.com/news" must be a host name. invalid filter`,
+		},
+		"Excluding dead and deleted items": {
+			filter:        commonModel.ItemFilter{ExcludeDead: true, ExcludeDeleted: true},
+			expectedQuery: bson.M{"dead": bson.M{"$ne": true}, "deleted": bson.M{"$ne": true}},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			query, err := itemFilter(testConfig.filter)
+			if testConfig.expectedErr != "" {
+				assert.EqualError(t, err, testConfig.expectedErr)
+				assert.ErrorIs(t, err, ErrInvalidFilter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedQuery, query)
+		})
+	}
+}
+
+func TestDomainPattern(t *testing.T) {
+	pattern, err := domainPattern("example.com")
+	require.NoError(t, err)
+	domainRegexp := regexp.MustCompile("(?i)" + pattern)
+
+	tests := map[string]struct {
+		url      string
+		expected bool
+	}{
+		"Domain":                     {url: "https://example.com", expected: true},
+		"Domain with a path":         {url: "http://example.com/news?id=1", expected: true},
+		"Subdomain":                  {url: "https://blog.example.com/post", expected: true},
+		"Different case":             {url: "https://Example.COM/", expected: true},
+		"Domain with a port":         {url: "https://example.com:8080/", expected: true},
+		"Domain with another suffix": {url: "https://example.com.evil.org/", expected: false},
+		"Domain with another prefix": {url: "https://notexample.com/", expected: false},
+		"Domain in the path":         {url: "https://other.org/example.com", expected: false},
+		"No url":                     {url: "", expected: false},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, domainRegexp.MatchString(testConfig.url))
+		})
+	}
+}
+
+func TestSortOrder(t *testing.T) {
+	item := &commonModel.Item{ID: 7, Score: 120}
+	tests := map[string]struct {
+		sort          commonModel.SortOrder
+		expectedSort  bson.D
+		expectedAfter bson.M
+	}{
+		"Newest by default": {
+			expectedSort:  bson.D{{Key: "id", Value: -1}},
+			expectedAfter: bson.M{"id": bson.M{"$lt": 7}},
+		},
+		"Oldest": {
+			sort:          commonModel.SortOldest,
+			expectedSort:  bson.D{{Key: "id", Value: 1}},
+			expectedAfter: bson.M{"id": bson.M{"$gt": 7}},
+		},
+		"Top score": {
+			sort:         commonModel.SortTopScore,
+			expectedSort: bson.D{{Key: "score", Value: -1}, {Key: "id", Value: -1}},
+			expectedAfter: bson.M{"$or": []bson.M{
+				{"score": bson.M{"$lt": 120}},
+				{"score": 120, "id": bson.M{"$lt": 7}},
+			}},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			order, err := newSortOrder(testConfig.sort)
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedSort, order.sort())
+
+			after, err := order.after(order.cursor(item))
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedAfter, after)
+		})
+	}
+
+	t.Run("Unsupported sort order", func(t *testing.T) {
+		_, err := newSortOrder("comments")
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
+	t.Run("Cursor from another sort order", func(t *testing.T) {
+		newest, err := newSortOrder(commonModel.SortNewest)
+		require.NoError(t, err)
+		oldest, err := newSortOrder(commonModel.SortOldest)
+		require.NoError(t, err)
+
+		_, err = oldest.after(newest.cursor(item))
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("Cursor that is not encoded", func(t *testing.T) {
+		newest, err := newSortOrder(commonModel.SortNewest)
+		require.NoError(t, err)
+
+		_, err = newest.after("not a cursor")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// relevanceField holds the text score of each search result, which is higher the more relevant the item is
+const relevanceField = "relevance"
+
+// searchDocument is an item found by a search along with its text score
+type searchDocument struct {
+	commonModel.Item `bson:",inline"`
+	Relevance        float64 `bson:"relevance"`
+}
+
+// searchPipeline builds the aggregation that reads a page of search results, along with one more result to tell
+// whether there is another page. Results are sorted by relevance then id, so that the cursor can hold both to resume
+// the search from
+func searchPipeline(request commonModel.SearchRequest, limit int) (mongo.Pipeline, error) {
+	if strings.TrimSpace(request.Query) == "" {
+		return nil, fmt.Errorf("Search query is empty. %w", ErrInvalidFilter)
+	}
+	filter, err := itemFilter(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	// The text search has to be in the first stage of the pipeline
+	filter["$text"] = bson.M{"$search": request.Query}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$addFields", Value: bson.M{relevanceField: bson.M{"$meta": "textScore"}}}},
+	}
+
+	if request.Cursor != "" {
+		relevance, id, err := decodeSearchCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": []bson.M{
+			{relevanceField: bson.M{"$lt": relevance}},
+			{relevanceField: relevance, "id": bson.M{"$lt": id}},
+		}}}})
+	}
+	return append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: relevanceField, Value: -1}, {Key: "id", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: limit + 1}},
+	), nil
+}
+
+// searchCursor encodes the relevance and id of the result, which is the last of a page. The relevance is encoded in
+// full so that it compares equal to the text score of the result on the next search
+func searchCursor(result *commonModel.SearchResult) string {
+	cursor := fmt.Sprintf("%s:%s:%d", relevanceField, strconv.FormatFloat(result.Relevance, 'g', -1, 64), result.Item.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodeSearchCursor(cursor string) (float64, int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unable to decode cursor %q. %w", cursor, ErrInvalidCursor)
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 3 || parts[0] != relevanceField {
+		return 0, 0, fmt.Errorf("Cursor %q is not for a search. %w", cursor, ErrInvalidCursor)
+	}
+	relevance, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unable to decode cursor %q. %w", cursor, ErrInvalidCursor)
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unable to decode cursor %q. %w", cursor, ErrInvalidCursor)
+	}
+	return relevance, id, nil
+}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"testing"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestSearchPipeline(t *testing.T) {
+	cursor := searchCursor(&commonModel.SearchResult{Item: &commonModel.Item{ID: 7}, Relevance: 1.0833333333333333})
+	tests := map[string]struct {
+		request          commonModel.SearchRequest
+		expectedPipeline mongo.Pipeline
+		expectedErr      error
+	}{
+		"First page": {
+			request: commonModel.SearchRequest{Query: "golang", Filter: commonModel.ItemFilter{Type: "story"}},
+			expectedPipeline: mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"type": "story", "$text": bson.M{"$search": "golang"}}}},
+				{{Key: "$addFields", Value: bson.M{"relevance": bson.M{"$meta": "textScore"}}}},
+				{{Key: "$sort", Value: bson.D{{Key: "relevance", Value: -1}, {Key: "id", Value: -1}}}},
+				{{Key: "$limit", Value: 11}},
+			},
+		},
+		"Following page": {
+			request: commonModel.SearchRequest{Query: "golang", Cursor: cursor},
+			expectedPipeline: mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"$text": bson.M{"$search": "golang"}}}},
+				{{Key: "$addFields", Value: bson.M{"relevance": bson.M{"$meta": "textScore"}}}},
+				{{Key: "$match", Value: bson.M{"$or": []bson.M{
+					{"relevance": bson.M{"$lt": 1.0833333333333333}},
+					{"relevance": 1.0833333333333333, "id": bson.M{"$lt": 7}},
+				}}}},
+				{{Key: "$sort", Value: bson.D{{Key: "relevance", Value: -1}, {Key: "id", Value: -1}}}},
+				{{Key: "$limit", Value: 11}},
+			},
+		},
+		"Empty query": {
+			request:     commonModel.SearchRequest{Query: " "},
+			expectedErr: ErrInvalidFilter,
+		},
+		"Invalid filter": {
+			request:     commonModel.SearchRequest{Query: "golang", Filter: commonModel.ItemFilter{Domain: "a/b"}},
+			expectedErr: ErrInvalidFilter,
+		},
+		"Cursor from a list": {
+			request:     commonModel.SearchRequest{Query: "golang", Cursor: sortOrders[commonModel.SortNewest].cursor(&commonModel.Item{ID: 7})},
+			expectedErr: ErrInvalidCursor,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			pipeline, err := searchPipeline(testConfig.request, 10)
+			if testConfig.expectedErr != nil {
+				assert.ErrorIs(t, err, testConfig.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedPipeline, pipeline)
+		})
+	}
+}
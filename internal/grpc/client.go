@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -9,16 +10,29 @@ import (
 	pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Client interface {
-	ListAll(ctx context.Context) ([]*model.Item, error)
-	ListStories(ctx context.Context) ([]*model.Item, error)
-	ListJobs(ctx context.Context) ([]*model.Item, error)
+	ListAll(ctx context.Context, page model.PageRequest) (*model.ItemPage, error)
+	ListStories(ctx context.Context, page model.PageRequest) (*model.ItemPage, error)
+	ListJobs(ctx context.Context, page model.PageRequest) (*model.ItemPage, error)
+	Search(ctx context.Context, request model.SearchRequest) (*model.SearchPage, error)
 	SaveItem(ctx context.Context, item *model.Item) error
+	SaveItems(ctx context.Context, items []*model.Item) ([]error, error)
+	GetItem(ctx context.Context, id int) (*model.Item, error)
+	GetUser(ctx context.Context, id string) (*model.User, error)
+	SaveUser(ctx context.Context, user *model.User) error
 }
 
+var (
+	// ErrNotFound is returned when the server has no record of the requested resource
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidArgument is returned when the server rejects the request, such as for an invalid filter or cursor
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
 type client struct {
 	grpcClient     pb.APIClient
 	grpcConnection *grpc.ClientConn
@@ -43,28 +57,36 @@ func NewClient(addr string, logger *zap.Logger) (*client, error) {
 	}, nil
 }
 
-func (c *client) ListAll(ctx context.Context) ([]*model.Item, error) {
-	stream, err := c.grpcClient.ListAll(ctx, &emptypb.Empty{})
+func (c *client) ListAll(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	response, err := c.grpcClient.ListAll(ctx, model.PageRequestToPListRequest(page))
 	if err != nil {
-		return nil, fmt.Errorf("An error occurred when streaming all. %w", err)
+		return nil, requestError("listing all", err)
 	}
-	return handleStreamItems(ctx, stream)
+	return toItemPage(response), nil
 }
 
-func (c *client) ListStories(ctx context.Context) ([]*model.Item, error) {
-	stream, err := c.grpcClient.ListStories(ctx, &emptypb.Empty{})
+func (c *client) ListStories(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	response, err := c.grpcClient.ListStories(ctx, model.PageRequestToPListRequest(page))
 	if err != nil {
-		return nil, fmt.Errorf("An error occurred when streaming stories. %w", err)
+		return nil, requestError("listing stories", err)
 	}
-	return handleStreamItems(ctx, stream)
+	return toItemPage(response), nil
 }
 
-func (c *client) ListJobs(ctx context.Context) ([]*model.Item, error) {
-	stream, err := c.grpcClient.ListJobs(ctx, &emptypb.Empty{})
+func (c *client) ListJobs(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	response, err := c.grpcClient.ListJobs(ctx, model.PageRequestToPListRequest(page))
 	if err != nil {
-		return nil, fmt.Errorf("An error occurred when streaming jobs. %w", err)
+		return nil, requestError("listing jobs", err)
 	}
-	return handleStreamItems(ctx, stream)
+	return toItemPage(response), nil
+}
+
+func (c *client) Search(ctx context.Context, request model.SearchRequest) (*model.SearchPage, error) {
+	response, err := c.grpcClient.Search(ctx, model.SearchRequestToPSearchRequest(request))
+	if err != nil {
+		return nil, requestError("searching", err)
+	}
+	return model.PSearchResponseToSearchPage(response), nil
 }
 
 func (c *client) Close() {
@@ -85,26 +107,87 @@ func (c *client) SaveItem(ctx context.Context, item *model.Item) error {
 	return nil
 }
 
-func handleStreamItems(ctx context.Context, stream interface{ Recv() (*pb.Item, error) }) ([]*model.Item, error) {
-	var items []*model.Item
-	isComplete := false
-	for !isComplete {
-		select {
-		case <-ctx.Done():
-			isComplete = true
-		default:
-			pbItem, err := stream.Recv()
-			if err != nil {
-				if err == io.EOF {
-					isComplete = true
-					break
-				} else {
-					return nil, fmt.Errorf("receiving item from server. %w", err)
-				}
+// SaveItems streams the items to the server. An error is returned when the call fails as a whole, otherwise the
+// returned slice holds the error of each item that could not be saved, in the same position as the item
+func (c *client) SaveItems(ctx context.Context, items []*model.Item) ([]error, error) {
+	stream, err := c.grpcClient.SaveItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred while trying to save items. %w", err)
+	}
+	for _, item := range items {
+		if err := stream.Send(model.ItemToPItem(*item)); err != nil {
+			// The reason the stream failed is returned by CloseAndRecv
+			if errors.Is(err, io.EOF) {
+				break
 			}
-			item := model.PItemToItem(pbItem)
-			items = append(items, &item)
+			return nil, fmt.Errorf("An error occurred while sending item %d. %w", item.ID, err)
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred while trying to save items. %w", err)
+	}
+	if len(response.Results) != len(items) {
+		return nil, fmt.Errorf("Expected %d results when saving items, got %d", len(items), len(response.Results))
+	}
+
+	itemErrs := make([]error, len(items))
+	for i, result := range response.Results {
+		if !result.Success {
+			itemErrs[i] = fmt.Errorf("Something went wrong save item with id %d. %s", result.Id, result.Error)
+		}
+	}
+	return itemErrs, nil
+}
+
+func (c *client) GetItem(ctx context.Context, id int) (*model.Item, error) {
+	pbItem, err := c.grpcClient.GetItem(ctx, &pb.ItemRequest{Id: int32(id)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("item %d %w", id, ErrNotFound)
 		}
+		return nil, fmt.Errorf("An error occurred while trying to get item. %w", err)
+	}
+	item := model.PItemToItem(pbItem)
+	return &item, nil
+}
+
+func (c *client) GetUser(ctx context.Context, id string) (*model.User, error) {
+	pbUser, err := c.grpcClient.GetUser(ctx, &pb.UserRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("user %s %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("An error occurred while trying to get user. %w", err)
+	}
+	user := model.PUserToUser(pbUser)
+	return &user, nil
+}
+
+func (c *client) SaveUser(ctx context.Context, user *model.User) error {
+	userResponse, err := c.grpcClient.SaveUser(ctx, model.UserToPUser(*user))
+	if err != nil {
+		return fmt.Errorf("An error occurred while trying to save user. %w", err)
+	}
+	if !userResponse.Success {
+		return fmt.Errorf("Something went wrong save user with id %s", userResponse.Id)
+	}
+	return nil
+}
+
+// requestError wraps ErrInvalidArgument when the server rejected the request
+func requestError(action string, err error) error {
+	if status.Code(err) == codes.InvalidArgument {
+		return fmt.Errorf("%s %w", status.Convert(err).Message(), ErrInvalidArgument)
+	}
+	return fmt.Errorf("An error occurred when %s. %w", action, err)
+}
+
+func toItemPage(response *pb.ListResponse) *model.ItemPage {
+	items := make([]*model.Item, len(response.Items))
+	for i, pbItem := range response.Items {
+		item := model.PItemToItem(pbItem)
+		items[i] = &item
 	}
-	return items, nil
+	return &model.ItemPage{Items: items, NextCursor: response.NextCursor}
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestListAll(t *testing.T) {
@@ -20,45 +22,44 @@ func TestListAll(t *testing.T) {
 	defer controller.Finish()
 	tests := map[string]struct {
 		grpcClient         *pb.MockAPIClient
-		listAllClient      *pb.MockAPI_ListAllClient
-		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListAllClient)
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
 		expectedNumItems   int
+		expectedCursor     string
+		expectedErr        error
 		expectedErrMessage string
 	}{
 		"Successfully ListAll": {
 			grpcClient:       pb.NewMockAPIClient(controller),
-			listAllClient:    pb.NewMockAPI_ListAllClient(controller),
 			expectedNumItems: 2,
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListAllClient) {
-				mock.EXPECT().ListAll(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(&pb.Item{
-					Id:   1,
-					Type: "story",
+			expectedCursor:   "MQ",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListAll(gomock.Any(), &pb.ListRequest{
+					PageSize: 2,
+					Cursor:   "Mw",
+					Filter:   &pb.ItemFilter{Author: "pg", ExcludeDead: true},
+					Sort:     pb.SortOrder_TOP_SCORE,
+				}).Return(&pb.ListResponse{
+					Items: []*pb.Item{
+						{Id: 2, Type: "story"},
+						{Id: 1, Type: "job"},
+					},
+					NextCursor: "MQ",
 				}, nil)
-				ret.EXPECT().Recv().Return(
-					&pb.Item{
-						Id:   2,
-						Type: "job",
-					}, nil)
-				ret.EXPECT().Recv().Return(nil, io.EOF)
 			},
 		},
 		"Error in grpc client": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "An error occurred when streaming all. Failed to stream",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListAllClient) {
-				mock.EXPECT().ListAll(gomock.Any(), gomock.Any()).Return(ret, errors.New("Failed to stream"))
+			expectedErrMessage: "An error occurred when listing all. Failed to list",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("Failed to list"))
 			},
 		},
-		"Error in streaming": {
+		"Invalid cursor": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			listAllClient:      pb.NewMockAPI_ListAllClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "receiving item from server. failed",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListAllClient) {
-				mock.EXPECT().ListAll(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(nil, errors.New("failed"))
+			expectedErr:        ErrInvalidArgument,
+			expectedErrMessage: `invalid cursor "Mw" invalid argument`,
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListAll(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, `invalid cursor "Mw"`))
 			},
 		},
 	}
@@ -72,16 +73,25 @@ func TestListAll(t *testing.T) {
 				logger:     logger,
 			}
 			if testConfig.expectedMocks != nil {
-				testConfig.expectedMocks(t, testConfig.grpcClient, testConfig.listAllClient)
+				testConfig.expectedMocks(t, testConfig.grpcClient)
 			}
 
-			allItems, err := c.ListAll(context.TODO())
+			page, err := c.ListAll(context.TODO(), commonModel.PageRequest{
+				Limit:  2,
+				Cursor: "Mw",
+				Filter: commonModel.ItemFilter{Author: "pg", ExcludeDead: true},
+				Sort:   commonModel.SortTopScore,
+			})
 			if strings.TrimSpace(testConfig.expectedErrMessage) != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
-				assert.Nil(t, allItems)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+				}
+				assert.Nil(t, page)
 			} else {
 				require.NoError(t, err)
-				assert.Len(t, allItems, testConfig.expectedNumItems)
+				assert.Len(t, page.Items, testConfig.expectedNumItems)
+				assert.Equal(t, testConfig.expectedCursor, page.NextCursor)
 			}
 		})
 	}
@@ -92,45 +102,44 @@ func TestListStories(t *testing.T) {
 	defer controller.Finish()
 	tests := map[string]struct {
 		grpcClient         *pb.MockAPIClient
-		listClient         *pb.MockAPI_ListStoriesClient
-		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListStoriesClient)
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
 		expectedNumItems   int
+		expectedCursor     string
+		expectedErr        error
 		expectedErrMessage string
 	}{
 		"Successfully ListStories": {
 			grpcClient:       pb.NewMockAPIClient(controller),
-			listClient:       pb.NewMockAPI_ListStoriesClient(controller),
 			expectedNumItems: 2,
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListStoriesClient) {
-				mock.EXPECT().ListStories(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(&pb.Item{
-					Id:   1,
-					Type: "story",
+			expectedCursor:   "MQ",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListStories(gomock.Any(), &pb.ListRequest{
+					PageSize: 2,
+					Cursor:   "Mw",
+					Filter:   &pb.ItemFilter{Author: "pg", ExcludeDead: true},
+					Sort:     pb.SortOrder_TOP_SCORE,
+				}).Return(&pb.ListResponse{
+					Items: []*pb.Item{
+						{Id: 2, Type: "story"},
+						{Id: 1, Type: "story"},
+					},
+					NextCursor: "MQ",
 				}, nil)
-				ret.EXPECT().Recv().Return(
-					&pb.Item{
-						Id:   2,
-						Type: "story",
-					}, nil)
-				ret.EXPECT().Recv().Return(nil, io.EOF)
 			},
 		},
 		"Error in grpc client": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "An error occurred when streaming stories. Failed to stream",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListStoriesClient) {
-				mock.EXPECT().ListStories(gomock.Any(), gomock.Any()).Return(ret, errors.New("Failed to stream"))
+			expectedErrMessage: "An error occurred when listing stories. Failed to list",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListStories(gomock.Any(), gomock.Any()).Return(nil, errors.New("Failed to list"))
 			},
 		},
-		"Error in streaming": {
+		"Invalid cursor": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			listClient:         pb.NewMockAPI_ListStoriesClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "receiving item from server. failed",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListStoriesClient) {
-				mock.EXPECT().ListStories(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(nil, errors.New("failed"))
+			expectedErr:        ErrInvalidArgument,
+			expectedErrMessage: `invalid cursor "Mw" invalid argument`,
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListStories(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, `invalid cursor "Mw"`))
 			},
 		},
 	}
@@ -144,16 +153,25 @@ func TestListStories(t *testing.T) {
 				logger:     logger,
 			}
 			if testConfig.expectedMocks != nil {
-				testConfig.expectedMocks(t, testConfig.grpcClient, testConfig.listClient)
+				testConfig.expectedMocks(t, testConfig.grpcClient)
 			}
 
-			allItems, err := c.ListStories(context.TODO())
+			page, err := c.ListStories(context.TODO(), commonModel.PageRequest{
+				Limit:  2,
+				Cursor: "Mw",
+				Filter: commonModel.ItemFilter{Author: "pg", ExcludeDead: true},
+				Sort:   commonModel.SortTopScore,
+			})
 			if strings.TrimSpace(testConfig.expectedErrMessage) != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
-				assert.Nil(t, allItems)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+				}
+				assert.Nil(t, page)
 			} else {
 				require.NoError(t, err)
-				assert.Len(t, allItems, testConfig.expectedNumItems)
+				assert.Len(t, page.Items, testConfig.expectedNumItems)
+				assert.Equal(t, testConfig.expectedCursor, page.NextCursor)
 			}
 		})
 	}
@@ -164,45 +182,44 @@ func TestListJobs(t *testing.T) {
 	defer controller.Finish()
 	tests := map[string]struct {
 		grpcClient         *pb.MockAPIClient
-		listClient         *pb.MockAPI_ListJobsClient
-		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListJobsClient)
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
 		expectedNumItems   int
+		expectedCursor     string
+		expectedErr        error
 		expectedErrMessage string
 	}{
 		"Successfully ListJobs": {
 			grpcClient:       pb.NewMockAPIClient(controller),
-			listClient:       pb.NewMockAPI_ListJobsClient(controller),
 			expectedNumItems: 2,
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListJobsClient) {
-				mock.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(&pb.Item{
-					Id:   1,
-					Type: "job",
+			expectedCursor:   "MQ",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListJobs(gomock.Any(), &pb.ListRequest{
+					PageSize: 2,
+					Cursor:   "Mw",
+					Filter:   &pb.ItemFilter{Author: "pg", ExcludeDead: true},
+					Sort:     pb.SortOrder_TOP_SCORE,
+				}).Return(&pb.ListResponse{
+					Items: []*pb.Item{
+						{Id: 2, Type: "job"},
+						{Id: 1, Type: "job"},
+					},
+					NextCursor: "MQ",
 				}, nil)
-				ret.EXPECT().Recv().Return(
-					&pb.Item{
-						Id:   2,
-						Type: "job",
-					}, nil)
-				ret.EXPECT().Recv().Return(nil, io.EOF)
 			},
 		},
 		"Error in grpc client": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "An error occurred when streaming jobs. Failed to stream",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListJobsClient) {
-				mock.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(ret, errors.New("Failed to stream"))
+			expectedErrMessage: "An error occurred when listing jobs. Failed to list",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("Failed to list"))
 			},
 		},
-		"Error in streaming": {
+		"Invalid cursor": {
 			grpcClient:         pb.NewMockAPIClient(controller),
-			listClient:         pb.NewMockAPI_ListJobsClient(controller),
-			expectedNumItems:   0,
-			expectedErrMessage: "receiving item from server. failed",
-			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, ret *pb.MockAPI_ListJobsClient) {
-				mock.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(ret, nil)
-				ret.EXPECT().Recv().Return(nil, errors.New("failed"))
+			expectedErr:        ErrInvalidArgument,
+			expectedErrMessage: `invalid cursor "Mw" invalid argument`,
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, `invalid cursor "Mw"`))
 			},
 		},
 	}
@@ -216,16 +233,25 @@ func TestListJobs(t *testing.T) {
 				logger:     logger,
 			}
 			if testConfig.expectedMocks != nil {
-				testConfig.expectedMocks(t, testConfig.grpcClient, testConfig.listClient)
+				testConfig.expectedMocks(t, testConfig.grpcClient)
 			}
 
-			allItems, err := c.ListJobs(context.TODO())
+			page, err := c.ListJobs(context.TODO(), commonModel.PageRequest{
+				Limit:  2,
+				Cursor: "Mw",
+				Filter: commonModel.ItemFilter{Author: "pg", ExcludeDead: true},
+				Sort:   commonModel.SortTopScore,
+			})
 			if strings.TrimSpace(testConfig.expectedErrMessage) != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
-				assert.Nil(t, allItems)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+				}
+				assert.Nil(t, page)
 			} else {
 				require.NoError(t, err)
-				assert.Len(t, allItems, testConfig.expectedNumItems)
+				assert.Len(t, page.Items, testConfig.expectedNumItems)
+				assert.Equal(t, testConfig.expectedCursor, page.NextCursor)
 			}
 		})
 	}
@@ -292,3 +318,320 @@ func TestSaveItem(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveItems(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	items := []*commonModel.Item{{ID: 1}, {ID: 2}}
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient)
+		expectedItemErrs   []string
+		expectedErrMessage string
+	}{
+		"All items saved": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient) {
+				mock.EXPECT().SaveItems(gomock.Eq(context.TODO())).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Eq(&pb.Item{Id: 1})).Return(nil)
+				stream.EXPECT().Send(gomock.Eq(&pb.Item{Id: 2})).Return(nil)
+				stream.EXPECT().CloseAndRecv().Return(&pb.SaveItemsResponse{
+					Results: []*pb.ItemResponse{{Id: 1, Success: true}, {Id: 2, Success: true}},
+				}, nil)
+			},
+			expectedItemErrs: []string{"", ""},
+		},
+		"Some items failed to save": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient) {
+				mock.EXPECT().SaveItems(gomock.Eq(context.TODO())).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+				stream.EXPECT().CloseAndRecv().Return(&pb.SaveItemsResponse{
+					Results: []*pb.ItemResponse{{Id: 1, Success: false, Error: "Duplicate key"}, {Id: 2, Success: true}},
+				}, nil)
+			},
+			expectedItemErrs: []string{"Something went wrong save item with id 1. Duplicate key", ""},
+		},
+		"Error opening the stream": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient) {
+				mock.EXPECT().SaveItems(gomock.Eq(context.TODO())).Return(nil, errors.New("Unavailable"))
+			},
+			expectedErrMessage: "An error occurred while trying to save items. Unavailable",
+		},
+		"Stream closed by the server": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient) {
+				mock.EXPECT().SaveItems(gomock.Eq(context.TODO())).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Eq(&pb.Item{Id: 1})).Return(io.EOF)
+				stream.EXPECT().CloseAndRecv().Return(nil, errors.New("Failed to receive"))
+			},
+			expectedErrMessage: "An error occurred while trying to save items. Failed to receive",
+		},
+		"Missing results": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient, stream *pb.MockAPI_SaveItemsClient) {
+				mock.EXPECT().SaveItems(gomock.Eq(context.TODO())).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+				stream.EXPECT().CloseAndRecv().Return(&pb.SaveItemsResponse{
+					Results: []*pb.ItemResponse{{Id: 1, Success: true}},
+				}, nil)
+			},
+			expectedErrMessage: "Expected 2 results when saving items, got 1",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcClient := pb.NewMockAPIClient(controller)
+			testConfig.expectedMocks(t, grpcClient, pb.NewMockAPI_SaveItemsClient(controller))
+			c := client{grpcClient: grpcClient, logger: zap.NewNop()}
+
+			itemErrs, err := c.SaveItems(context.TODO(), items)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, itemErrs, len(testConfig.expectedItemErrs))
+			for i, expected := range testConfig.expectedItemErrs {
+				if expected == "" {
+					assert.NoError(t, itemErrs[i])
+				} else {
+					assert.EqualError(t, itemErrs[i], expected)
+				}
+			}
+		})
+	}
+}
+
+func TestGetItem(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
+		expectedItem       *commonModel.Item
+		expectedNotFound   bool
+		expectedErrMessage string
+	}{
+		"Successfully GetItem": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetItem(gomock.Eq(context.TODO()), gomock.Eq(&pb.ItemRequest{Id: 1})).Return(&pb.Item{
+					Id:   1,
+					Type: "story",
+					Kids: []int32{2},
+				}, nil)
+			},
+			expectedItem: &commonModel.Item{ID: 1, Type: "story", Kids: []int{2}},
+		},
+		"Item not found": {
+			expectedNotFound:   true,
+			expectedErrMessage: "item 1 not found",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetItem(gomock.Eq(context.TODO()), gomock.Eq(&pb.ItemRequest{Id: 1})).Return(nil, status.Error(codes.NotFound, "missing"))
+			},
+		},
+		"Error in grpc client": {
+			expectedErrMessage: "An error occurred while trying to get item. Failed to get",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetItem(gomock.Eq(context.TODO()), gomock.Eq(&pb.ItemRequest{Id: 1})).Return(nil, errors.New("Failed to get"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcClient := pb.NewMockAPIClient(controller)
+			testConfig.expectedMocks(t, grpcClient)
+			c := client{grpcClient: grpcClient, logger: zap.NewNop()}
+
+			item, err := c.GetItem(context.TODO(), 1)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				assert.Equal(t, testConfig.expectedNotFound, errors.Is(err, ErrNotFound))
+				assert.Nil(t, item)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testConfig.expectedItem, item)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	request := commonModel.SearchRequest{Query: "golang", Limit: 2, Cursor: "cursor", Filter: commonModel.ItemFilter{Author: "pg"}}
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
+		expectedPage       *commonModel.SearchPage
+		expectedErr        error
+		expectedErrMessage string
+	}{
+		"Successfully search": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().Search(gomock.Any(), &pb.SearchRequest{
+					Query:    "golang",
+					PageSize: 2,
+					Cursor:   "cursor",
+					Filter:   &pb.ItemFilter{Author: "pg"},
+				}).Return(&pb.SearchResponse{
+					Results:    []*pb.SearchResult{{Item: &pb.Item{Id: 1, Type: "story"}, Relevance: 1.5}},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedPage: &commonModel.SearchPage{
+				Results:    []*commonModel.SearchResult{{Item: &commonModel.Item{ID: 1, Type: "story"}, Relevance: 1.5}},
+				NextCursor: "next",
+			},
+		},
+		"Invalid query": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "Search query is empty."))
+			},
+			expectedErr:        ErrInvalidArgument,
+			expectedErrMessage: "Search query is empty. invalid argument",
+		},
+		"Error in grpc client": {
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("Failed to search"))
+			},
+			expectedErrMessage: "An error occurred when searching. Failed to search",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			grpcClient := pb.NewMockAPIClient(controller)
+			testConfig.expectedMocks(t, grpcClient)
+			c := client{
+				grpcClient: grpcClient,
+				logger:     zap.NewNop(),
+			}
+
+			page, err := c.Search(context.TODO(), request)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				if testConfig.expectedErr != nil {
+					assert.ErrorIs(t, err, testConfig.expectedErr)
+				}
+				assert.Nil(t, page)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testConfig.expectedPage, page)
+			}
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tests := map[string]struct {
+		grpcClient         *pb.MockAPIClient
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
+		expectedUser       *commonModel.User
+		expectedNotFound   bool
+		expectedErrMessage string
+	}{
+		"Successfully GetUser": {
+			grpcClient: pb.NewMockAPIClient(controller),
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.UserRequest{Id: "jl"})).Return(&pb.User{
+					Id:        "jl",
+					Karma:     10,
+					Submitted: []int32{1, 2},
+				}, nil)
+			},
+			expectedUser: &commonModel.User{ID: "jl", Karma: 10, Submitted: []int{1, 2}},
+		},
+		"User not found": {
+			grpcClient:         pb.NewMockAPIClient(controller),
+			expectedNotFound:   true,
+			expectedErrMessage: "user jl not found",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.UserRequest{Id: "jl"})).Return(nil, status.Error(codes.NotFound, "missing"))
+			},
+		},
+		"Error in grpc client": {
+			grpcClient:         pb.NewMockAPIClient(controller),
+			expectedErrMessage: "An error occurred while trying to get user. Failed to get",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().GetUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.UserRequest{Id: "jl"})).Return(nil, errors.New("Failed to get"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			logger, err := zap.NewDevelopment()
+			require.NoError(t, err)
+
+			c := client{
+				grpcClient: testConfig.grpcClient,
+				logger:     logger,
+			}
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, testConfig.grpcClient)
+			}
+
+			user, err := c.GetUser(context.TODO(), "jl")
+			if strings.TrimSpace(testConfig.expectedErrMessage) != "" {
+				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
+				assert.Equal(t, testConfig.expectedNotFound, errors.Is(err, ErrNotFound))
+				assert.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testConfig.expectedUser, user)
+			}
+		})
+	}
+}
+
+func TestSaveUser(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tests := map[string]struct {
+		grpcClient         *pb.MockAPIClient
+		expectedMocks      func(t *testing.T, mock *pb.MockAPIClient)
+		expectedErrMessage string
+	}{
+		"Successfully SaveUser": {
+			grpcClient: pb.NewMockAPIClient(controller),
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().SaveUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.User{Id: "jl"})).Return(&pb.UserResponse{
+					Id:      "jl",
+					Success: true,
+				}, nil)
+			},
+		},
+		"Error in grpc client": {
+			grpcClient:         pb.NewMockAPIClient(controller),
+			expectedErrMessage: "An error occurred while trying to save user. Failed to save",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().SaveUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.User{Id: "jl"})).Return(nil, errors.New("Failed to save"))
+			},
+		},
+		"Unsuccessful save": {
+			grpcClient:         pb.NewMockAPIClient(controller),
+			expectedErrMessage: "Something went wrong save user with id jl",
+			expectedMocks: func(t *testing.T, mock *pb.MockAPIClient) {
+				mock.EXPECT().SaveUser(gomock.Eq(context.TODO()), gomock.Eq(&pb.User{Id: "jl"})).Return(&pb.UserResponse{
+					Id:      "jl",
+					Success: false,
+				}, nil)
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			logger, err := zap.NewDevelopment()
+			require.NoError(t, err)
+
+			c := client{
+				grpcClient: testConfig.grpcClient,
+				logger:     logger,
+			}
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, testConfig.grpcClient)
+			}
+
+			err = c.SaveUser(context.TODO(), &commonModel.User{ID: "jl"})
+			if strings.TrimSpace(testConfig.expectedErrMessage) != "" {
+				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,14 +2,17 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/caching"
 	"github.com/emmaLP/gs-software-onboarding/internal/database"
 	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -27,24 +30,38 @@ func NewHandler(itemCache caching.Client, dbClient database.Client, logger *zap.
 	}
 }
 
-func (h *Handler) ListAll(empty *emptypb.Empty, s pb.API_ListAllServer) error {
-	return h.streamItems(s, func() ([]*model.Item, error) {
-		return h.itemCache.ListAll(s.Context())
+func (h *Handler) ListAll(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	return h.listItems(request, func(page model.PageRequest) (*model.ItemPage, error) {
+		return h.itemCache.ListAll(ctx, page)
 	})
 }
 
-func (h *Handler) ListStories(empty *emptypb.Empty, s pb.API_ListStoriesServer) error {
-	return h.streamItems(s, func() ([]*model.Item, error) {
-		return h.itemCache.ListStories(s.Context())
+func (h *Handler) ListStories(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	return h.listItems(request, func(page model.PageRequest) (*model.ItemPage, error) {
+		return h.itemCache.ListStories(ctx, page)
 	})
 }
 
-func (h *Handler) ListJobs(empty *emptypb.Empty, s pb.API_ListJobsServer) error {
-	return h.streamItems(s, func() ([]*model.Item, error) {
-		return h.itemCache.ListJobs(s.Context())
+func (h *Handler) ListJobs(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	return h.listItems(request, func(page model.PageRequest) (*model.ItemPage, error) {
+		return h.itemCache.ListJobs(ctx, page)
 	})
 }
 
+// Search returns the requested page of the items matching the query, rejecting filters that cannot be applied and
+// cursors that were not returned by a previous page
+func (h *Handler) Search(ctx context.Context, request *pb.SearchRequest) (*pb.SearchResponse, error) {
+	page, err := h.dbClient.Search(ctx, model.PSearchRequestToSearchRequest(request))
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidCursor) || errors.Is(err, database.ErrInvalidFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		h.logger.Error("Failed to search items.", zap.String("query", request.Query), zap.Error(err))
+		return nil, err
+	}
+	return model.SearchPageToPSearchResponse(page), nil
+}
+
 func (h *Handler) SaveItem(ctx context.Context, item *pb.Item) (*pb.ItemResponse, error) {
 	toItem := model.PItemToItem(item)
 	err := h.dbClient.SaveItem(ctx, &toItem)
@@ -52,19 +69,126 @@ func (h *Handler) SaveItem(ctx context.Context, item *pb.Item) (*pb.ItemResponse
 		h.logger.Error("Failed to save item to the database.", zap.Error(err))
 		return &pb.ItemResponse{Id: item.Id, Success: false}, err
 	}
+	h.evictItems(ctx, toItem.ID)
 	return &pb.ItemResponse{Id: item.Id, Success: true}, nil
 }
 
-func (h *Handler) streamItems(server interface{ Send(item *pb.Item) error }, itemsFunc func() ([]*model.Item, error)) error {
-	items, err := itemsFunc()
+// evictItems removes the cached copies of saved items. The items are already saved, so a failure is only logged and
+// the cached copies expire with their TTL
+func (h *Handler) evictItems(ctx context.Context, ids ...int) {
+	if err := h.itemCache.EvictItems(ctx, ids...); err != nil {
+		h.logger.Warn("Failed to evict saved items from the cache.", zap.Ints("ids", ids), zap.Error(err))
+	}
+}
+
+// saveItemsChunkSize is the number of streamed items written to the database in each bulk write
+const saveItemsChunkSize = 500
+
+// SaveItems saves the streamed items with bulk writes, returning the result of each item in the order they were sent
+// once the client closes the stream
+func (h *Handler) SaveItems(s pb.API_SaveItemsServer) error {
+	var results []*pb.ItemResponse
+	chunk := make([]*model.Item, 0, saveItemsChunkSize)
+	for {
+		pbItem, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("Unable to receive item. %w", err)
+		}
+		item := model.PItemToItem(pbItem)
+		chunk = append(chunk, &item)
+		if len(chunk) == saveItemsChunkSize {
+			results = append(results, h.saveChunk(s.Context(), chunk)...)
+			chunk = make([]*model.Item, 0, saveItemsChunkSize)
+		}
+	}
+	results = append(results, h.saveChunk(s.Context(), chunk)...)
+	return s.SendAndClose(&pb.SaveItemsResponse{Results: results})
+}
+
+// saveChunk saves the items in one bulk write. Every item is reported as failed when the write fails as a whole
+func (h *Handler) saveChunk(ctx context.Context, items []*model.Item) []*pb.ItemResponse {
+	if len(items) == 0 {
+		return nil
+	}
+	itemErrs, err := h.dbClient.SaveItems(ctx, items)
+	if err != nil {
+		h.logger.Error("Failed to save items to the database.", zap.Int("count", len(items)), zap.Error(err))
+	}
+
+	results := make([]*pb.ItemResponse, len(items))
+	var saved []int
+	for i, item := range items {
+		result := &pb.ItemResponse{Id: int32(item.ID), Success: true}
+		switch {
+		case err != nil:
+			result.Success = false
+			result.Error = err.Error()
+		case itemErrs[i] != nil:
+			h.logger.Error("Failed to save item to the database.", zap.Int("id", item.ID), zap.Error(itemErrs[i]))
+			result.Success = false
+			result.Error = itemErrs[i].Error()
+		default:
+			saved = append(saved, item.ID)
+		}
+		results[i] = result
+	}
+	if len(saved) > 0 {
+		h.evictItems(ctx, saved...)
+	}
+	return results
+}
+
+func (h *Handler) GetItem(ctx context.Context, request *pb.ItemRequest) (*pb.Item, error) {
+	item, err := h.itemCache.GetItem(ctx, int(request.Id))
 	if err != nil {
-		return fmt.Errorf("fetching all items, %w", err)
+		if errors.Is(err, database.ErrItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "item %d not found", request.Id)
+		}
+		h.logger.Error("Failed to retrieve item.", zap.Int32("id", request.Id), zap.Error(err))
+		return nil, err
 	}
+	return model.ItemToPItem(*item), nil
+}
 
-	for _, item := range items {
-		if err := server.Send(model.ItemToPItem(*item)); err != nil {
-			return fmt.Errorf("steaming item to client. %w", err)
+func (h *Handler) GetUser(ctx context.Context, request *pb.UserRequest) (*pb.User, error) {
+	user, err := h.dbClient.GetUser(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, database.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", request.Id)
 		}
+		h.logger.Error("Failed to retrieve user from the database.", zap.Error(err))
+		return nil, err
+	}
+	return model.UserToPUser(*user), nil
+}
+
+func (h *Handler) SaveUser(ctx context.Context, user *pb.User) (*pb.UserResponse, error) {
+	toUser := model.PUserToUser(user)
+	err := h.dbClient.SaveUser(ctx, &toUser)
+	if err != nil {
+		h.logger.Error("Failed to save user to the database.", zap.Error(err))
+		return &pb.UserResponse{Id: user.Id, Success: false}, err
+	}
+	return &pb.UserResponse{Id: user.Id, Success: true}, nil
+}
+
+// listItems returns the requested page of items, rejecting filters that cannot be applied and cursors that were not
+// returned by a previous page
+func (h *Handler) listItems(request *pb.ListRequest, pageFunc func(page model.PageRequest) (*model.ItemPage, error)) (*pb.ListResponse, error) {
+	page, err := pageFunc(model.PListRequestToPageRequest(request))
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidCursor) || errors.Is(err, database.ErrInvalidFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, fmt.Errorf("fetching items, %w", err)
+	}
+
+	items := make([]*pb.Item, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = model.ItemToPItem(*item)
 	}
-	return nil
+	return &pb.ListResponse{Items: items, NextCursor: page.NextCursor}, nil
 }
@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/caching"
@@ -14,104 +16,105 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestListMethods(t *testing.T) {
 	items := []*commonModel.Item{
-		{ID: 1, Type: "story"},
-		{ID: 2, Type: "job"},
+		{ID: 2, Type: "story"},
+		{ID: 1, Type: "job"},
+	}
+	minScore := 100
+	page := commonModel.PageRequest{
+		Limit:  2,
+		Cursor: "Mw",
+		Filter: commonModel.ItemFilter{Author: "pg", MinScore: &minScore},
+		Sort:   commonModel.SortTopScore,
+	}
+	pbMinScore := int64(100)
+	request := &pbMock.ListRequest{
+		PageSize: 2,
+		Cursor:   "Mw",
+		Filter:   &pbMock.ItemFilter{Author: "pg", MinScore: &pbMinScore},
+		Sort:     pbMock.SortOrder_TOP_SCORE,
 	}
 	tests := map[string]struct {
-		cacheMock         *caching.Mock
-		expectedMocks     func(t *testing.T, cacheMock *caching.Mock)
-		itemsToSend       []*commonModel.Item
-		listAllServer     func(t *testing.T) *pbMock.MockAPI_ListAllServer
-		listStoriesServer func(t *testing.T) *pbMock.MockAPI_ListStoriesServer
-		listJobsServer    func(t *testing.T) *pbMock.MockAPI_ListJobsServer
+		method           string
+		expectedMocks    func(t *testing.T, cacheMock *caching.Mock)
+		expectedResponse *pbMock.ListResponse
+		expectedCode     codes.Code
 	}{
 		"ListAll Successfully": {
-			cacheMock:   &caching.Mock{},
-			itemsToSend: items,
+			method: "ListAll",
 			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
-				cacheMock.On("ListAll", context.TODO()).Return(items, nil)
+				cacheMock.On("ListAll", context.TODO(), page).Return(&commonModel.ItemPage{Items: items, NextCursor: "MQ"}, nil)
 			},
-			listAllServer: func(t *testing.T) *pbMock.MockAPI_ListAllServer {
-				controller := gomock.NewController(t)
-				defer controller.Finish()
-				return pbMock.NewMockAPI_ListAllServer(controller)
+			expectedResponse: &pbMock.ListResponse{
+				Items:      []*pbMock.Item{commonModel.ItemToPItem(*items[0]), commonModel.ItemToPItem(*items[1])},
+				NextCursor: "MQ",
 			},
 		},
 		"ListStories Successfully": {
-			cacheMock:   &caching.Mock{},
-			itemsToSend: items,
+			method: "ListStories",
 			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
-				cacheMock.On("ListStories", context.TODO()).Return(items, nil)
-			},
-			listStoriesServer: func(t *testing.T) *pbMock.MockAPI_ListStoriesServer {
-				controller := gomock.NewController(t)
-				defer controller.Finish()
-				return pbMock.NewMockAPI_ListStoriesServer(controller)
+				cacheMock.On("ListStories", context.TODO(), page).Return(&commonModel.ItemPage{Items: items[:1]}, nil)
 			},
+			expectedResponse: &pbMock.ListResponse{Items: []*pbMock.Item{commonModel.ItemToPItem(*items[0])}},
 		},
 		"ListJobs Successfully": {
-			cacheMock:   &caching.Mock{},
-			itemsToSend: items,
+			method: "ListJobs",
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("ListJobs", context.TODO(), page).Return(&commonModel.ItemPage{Items: items[1:]}, nil)
+			},
+			expectedResponse: &pbMock.ListResponse{Items: []*pbMock.Item{commonModel.ItemToPItem(*items[1])}},
+		},
+		"Invalid cursor": {
+			method: "ListAll",
 			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
-				cacheMock.On("ListJobs", context.TODO()).Return(items, nil)
+				cacheMock.On("ListAll", context.TODO(), page).Return(nil, database.ErrInvalidCursor)
 			},
-			listJobsServer: func(t *testing.T) *pbMock.MockAPI_ListJobsServer {
-				controller := gomock.NewController(t)
-				defer controller.Finish()
-				return pbMock.NewMockAPI_ListJobsServer(controller)
+			expectedCode: codes.InvalidArgument,
+		},
+		"Invalid filter": {
+			method: "ListStories",
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("ListStories", context.TODO(), page).Return(nil, database.ErrInvalidFilter)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		"Failed to list": {
+			method: "ListJobs",
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("ListJobs", context.TODO(), page).Return(nil, errors.New("Failed to list"))
 			},
+			expectedCode: codes.Unknown,
 		},
 	}
 
 	for testName, testConfig := range tests {
 		t.Run(testName, func(t *testing.T) {
-			if testConfig.expectedMocks != nil {
-				testConfig.expectedMocks(t, testConfig.cacheMock)
-			}
+			cacheMock := &caching.Mock{}
+			testConfig.expectedMocks(t, cacheMock)
 			handler := Handler{
-				itemCache: testConfig.cacheMock,
-			}
-			var err error
-			if testConfig.listAllServer != nil {
-				apiServer := testConfig.listAllServer(t)
-				apiServer.EXPECT().Context().Return(context.TODO())
-				for _, item := range testConfig.itemsToSend {
-					apiServer.EXPECT().Send(
-						gomock.Eq(commonModel.ItemToPItem(*item)),
-					).Return(nil)
-				}
-
-				err = handler.ListAll(nil, apiServer)
-			} else if testConfig.listJobsServer != nil {
-				apiServer := testConfig.listJobsServer(t)
-				apiServer.EXPECT().Context().Return(context.TODO())
-				for _, item := range testConfig.itemsToSend {
-					apiServer.EXPECT().Send(
-						gomock.Eq(commonModel.ItemToPItem(*item)),
-					).Return(nil)
-				}
-
-				err = handler.ListJobs(nil, apiServer)
-			} else if testConfig.listStoriesServer != nil {
-				apiServer := testConfig.listStoriesServer(t)
-				apiServer.EXPECT().Context().Return(context.TODO())
-				for _, item := range testConfig.itemsToSend {
-					apiServer.EXPECT().Send(
-						gomock.Eq(commonModel.ItemToPItem(*item)),
-					).Return(nil)
-				}
-
-				err = handler.ListStories(nil, apiServer)
-			}
-
-			assert.NoError(t, err)
-			if testConfig.expectedMocks != nil {
-				testConfig.cacheMock.AssertExpectations(t)
+				itemCache: cacheMock,
+				logger:    zap.NewNop(),
+			}
+			listFuncs := map[string]func(context.Context, *pbMock.ListRequest) (*pbMock.ListResponse, error){
+				"ListAll":     handler.ListAll,
+				"ListStories": handler.ListStories,
+				"ListJobs":    handler.ListJobs,
+			}
+
+			response, err := listFuncs[testConfig.method](context.TODO(), request)
+			if testConfig.expectedResponse == nil {
+				assert.Equal(t, testConfig.expectedCode, status.Code(err))
+				assert.Nil(t, response)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testConfig.expectedResponse, response)
 			}
+			cacheMock.AssertExpectations(t)
 		})
 	}
 }
@@ -120,34 +123,44 @@ func TestHandler_SaveItem(t *testing.T) {
 	tests := map[string]struct {
 		dbMock             *database.Mock
 		itemToSave         *pbMock.Item
-		expectedMocks      func(t *testing.T, dbMock *database.Mock)
+		expectedMocks      func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock)
 		expectedErrMessage string
 	}{
 		"Successful save": {
 			dbMock:     &database.Mock{},
 			itemToSave: &pbMock.Item{Id: 1},
-			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
+				dbMock.On("SaveItem", context.TODO(), mock.Anything).Return(nil)
+				cacheMock.On("EvictItems", context.TODO(), []int{1}).Return(nil).Once()
+			},
+		},
+		"Saved when the cached item cannot be evicted": {
+			dbMock:     &database.Mock{},
+			itemToSave: &pbMock.Item{Id: 1},
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
 				dbMock.On("SaveItem", context.TODO(), mock.Anything).Return(nil)
+				cacheMock.On("EvictItems", context.TODO(), []int{1}).Return(errors.New("Failed to evict.")).Once()
 			},
 		},
 		"Unsuccessful save": {
 			dbMock:             &database.Mock{},
 			itemToSave:         &pbMock.Item{Id: 1},
 			expectedErrMessage: "Failed to save.",
-			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
 				dbMock.On("SaveItem", context.TODO(), mock.Anything).Return(errors.New("Failed to save."))
 			},
 		},
 	}
 	for testName, testConfig := range tests {
 		t.Run(testName, func(t *testing.T) {
+			cacheMock := &caching.Mock{}
 			if testConfig.expectedMocks != nil {
-				testConfig.expectedMocks(t, testConfig.dbMock)
+				testConfig.expectedMocks(t, testConfig.dbMock, cacheMock)
 			}
 			logger, err := zap.NewDevelopment()
 			require.NoError(t, err)
 
-			handler := NewHandler(nil, testConfig.dbMock, logger)
+			handler := NewHandler(cacheMock, testConfig.dbMock, logger)
 			itemResponse, err := handler.SaveItem(context.TODO(), testConfig.itemToSave)
 			if testConfig.expectedErrMessage != "" {
 				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
@@ -161,6 +174,316 @@ func TestHandler_SaveItem(t *testing.T) {
 			if testConfig.expectedMocks != nil {
 				testConfig.dbMock.AssertExpectations(t)
 			}
+			cacheMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_SaveItems(t *testing.T) {
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock)
+		recvErr            error
+		expectedResults    []*pbMock.ItemResponse
+		expectedErrMessage string
+	}{
+		"All items saved": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
+				dbMock.On("SaveItems", context.TODO(), []*commonModel.Item{{ID: 1}, {ID: 2}}).Return([]error{nil, nil}, nil)
+				cacheMock.On("EvictItems", context.TODO(), []int{1, 2}).Return(nil).Once()
+			},
+			expectedResults: []*pbMock.ItemResponse{{Id: 1, Success: true}, {Id: 2, Success: true}},
+		},
+		"Some items failed to save": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
+				dbMock.On("SaveItems", context.TODO(), []*commonModel.Item{{ID: 1}, {ID: 2}}).
+					Return([]error{nil, errors.New("Duplicate key")}, nil)
+				cacheMock.On("EvictItems", context.TODO(), []int{1}).Return(nil).Once()
+			},
+			expectedResults: []*pbMock.ItemResponse{{Id: 1, Success: true}, {Id: 2, Success: false, Error: "Duplicate key"}},
+		},
+		"Bulk write failed": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {
+				dbMock.On("SaveItems", context.TODO(), []*commonModel.Item{{ID: 1}, {ID: 2}}).Return(nil, errors.New("Failed to save."))
+			},
+			expectedResults: []*pbMock.ItemResponse{
+				{Id: 1, Success: false, Error: "Failed to save."},
+				{Id: 2, Success: false, Error: "Failed to save."},
+			},
+		},
+		"Stream failed": {
+			expectedMocks:      func(t *testing.T, dbMock *database.Mock, cacheMock *caching.Mock) {},
+			recvErr:            errors.New("Connection reset"),
+			expectedErrMessage: "Unable to receive item. Connection reset",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dbMock, cacheMock := &database.Mock{}, &caching.Mock{}
+			testConfig.expectedMocks(t, dbMock, cacheMock)
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			apiServer := pbMock.NewMockAPI_SaveItemsServer(controller)
+			apiServer.EXPECT().Context().Return(context.TODO()).AnyTimes()
+			if testConfig.recvErr != nil {
+				apiServer.EXPECT().Recv().Return(nil, testConfig.recvErr)
+			} else {
+				gomock.InOrder(
+					apiServer.EXPECT().Recv().Return(&pbMock.Item{Id: 1}, nil),
+					apiServer.EXPECT().Recv().Return(&pbMock.Item{Id: 2}, nil),
+					apiServer.EXPECT().Recv().Return(nil, io.EOF),
+				)
+				apiServer.EXPECT().SendAndClose(gomock.Eq(&pbMock.SaveItemsResponse{Results: testConfig.expectedResults})).Return(nil)
+			}
+
+			handler := NewHandler(cacheMock, dbMock, zap.NewNop())
+			err := handler.SaveItems(apiServer)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+			dbMock.AssertExpectations(t)
+			cacheMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_SaveItemsChunks(t *testing.T) {
+	dbMock := &database.Mock{}
+	dbMock.On("SaveItems", context.TODO(), mock.MatchedBy(func(items []*commonModel.Item) bool {
+		return len(items) == saveItemsChunkSize
+	})).Return(make([]error, saveItemsChunkSize), nil).Once()
+	dbMock.On("SaveItems", context.TODO(), []*commonModel.Item{{ID: saveItemsChunkSize + 1}}).Return([]error{nil}, nil).Once()
+	cacheMock := &caching.Mock{}
+	cacheMock.On("EvictItems", context.TODO(), mock.Anything).Return(nil).Twice()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	apiServer := pbMock.NewMockAPI_SaveItemsServer(controller)
+	apiServer.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	for id := int32(1); id <= saveItemsChunkSize+1; id++ {
+		apiServer.EXPECT().Recv().Return(&pbMock.Item{Id: id}, nil)
+	}
+	apiServer.EXPECT().Recv().Return(nil, io.EOF)
+	apiServer.EXPECT().SendAndClose(gomock.Any()).DoAndReturn(func(response *pbMock.SaveItemsResponse) error {
+		assert.Len(t, response.Results, saveItemsChunkSize+1)
+		return nil
+	})
+
+	err := NewHandler(cacheMock, dbMock, zap.NewNop()).SaveItems(apiServer)
+	assert.NoError(t, err)
+	dbMock.AssertExpectations(t)
+	cacheMock.AssertExpectations(t)
+}
+
+func TestHandler_GetItem(t *testing.T) {
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, cacheMock *caching.Mock)
+		expectedItem       *pbMock.Item
+		expectedCode       codes.Code
+		expectedErrMessage string
+	}{
+		"Successfully get item": {
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("GetItem", context.TODO(), 1).Return(&commonModel.Item{ID: 1, Type: "story", Kids: []int{2}}, nil)
+			},
+			expectedItem: &pbMock.Item{Id: 1, Type: "story", Kids: []int32{2}},
+		},
+		"Item not found": {
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("GetItem", context.TODO(), 1).Return(nil, database.ErrItemNotFound)
+			},
+			expectedCode:       codes.NotFound,
+			expectedErrMessage: "rpc error: code = NotFound desc = item 1 not found",
+		},
+		"Database failure": {
+			expectedMocks: func(t *testing.T, cacheMock *caching.Mock) {
+				cacheMock.On("GetItem", context.TODO(), 1).Return(nil, errors.New("Failed to find."))
+			},
+			expectedCode:       codes.Unknown,
+			expectedErrMessage: "Failed to find.",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			cacheMock := &caching.Mock{}
+			testConfig.expectedMocks(t, cacheMock)
+
+			handler := NewHandler(cacheMock, nil, zap.NewNop())
+			item, err := handler.GetItem(context.TODO(), &pbMock.ItemRequest{Id: 1})
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				assert.Equal(t, testConfig.expectedCode, status.Code(err))
+				assert.Nil(t, item)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testConfig.expectedItem, item)
+			}
+			cacheMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_Search(t *testing.T) {
+	request := commonModel.SearchRequest{Query: "golang", Limit: 2, Filter: commonModel.ItemFilter{Type: "story"}}
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, dbMock *database.Mock)
+		expectedResponse   *pbMock.SearchResponse
+		expectedCode       codes.Code
+		expectedErrMessage string
+	}{
+		"Successfully search": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("Search", context.TODO(), request).Return(&commonModel.SearchPage{
+					Results:    []*commonModel.SearchResult{{Item: &commonModel.Item{ID: 1, Type: "story"}, Relevance: 1.5}},
+					NextCursor: "cursor",
+				}, nil)
+			},
+			expectedResponse: &pbMock.SearchResponse{
+				Results:    []*pbMock.SearchResult{{Item: &pbMock.Item{Id: 1, Type: "story"}, Relevance: 1.5}},
+				NextCursor: "cursor",
+			},
+		},
+		"Invalid query": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("Search", context.TODO(), request).Return(nil, fmt.Errorf("Search query is empty. %w", database.ErrInvalidFilter))
+			},
+			expectedCode:       codes.InvalidArgument,
+			expectedErrMessage: "rpc error: code = InvalidArgument desc = Search query is empty. invalid filter",
+		},
+		"Database failure": {
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("Search", context.TODO(), request).Return(nil, errors.New("Failed to search."))
+			},
+			expectedCode:       codes.Unknown,
+			expectedErrMessage: "Failed to search.",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dbMock := &database.Mock{}
+			testConfig.expectedMocks(t, dbMock)
+
+			handler := NewHandler(nil, dbMock, zap.NewNop())
+			response, err := handler.Search(context.TODO(), &pbMock.SearchRequest{
+				Query:    "golang",
+				PageSize: 2,
+				Filter:   &pbMock.ItemFilter{Type: "story"},
+			})
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				assert.Equal(t, testConfig.expectedCode, status.Code(err))
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testConfig.expectedResponse, response)
+			}
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetUser(t *testing.T) {
+	tests := map[string]struct {
+		dbMock             *database.Mock
+		expectedMocks      func(t *testing.T, dbMock *database.Mock)
+		expectedUser       *pbMock.User
+		expectedCode       codes.Code
+		expectedErrMessage string
+	}{
+		"Successfully get user": {
+			dbMock: &database.Mock{},
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetUser", context.TODO(), "jl").Return(&commonModel.User{ID: "jl", Karma: 5, Submitted: []int{1}}, nil)
+			},
+			expectedUser: &pbMock.User{Id: "jl", Karma: 5, Submitted: []int32{1}},
+		},
+		"User not found": {
+			dbMock: &database.Mock{},
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetUser", context.TODO(), "jl").Return(nil, database.ErrUserNotFound)
+			},
+			expectedCode:       codes.NotFound,
+			expectedErrMessage: "rpc error: code = NotFound desc = user jl not found",
+		},
+		"Database failure": {
+			dbMock: &database.Mock{},
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("GetUser", context.TODO(), "jl").Return(nil, errors.New("Failed to find."))
+			},
+			expectedCode:       codes.Unknown,
+			expectedErrMessage: "Failed to find.",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, testConfig.dbMock)
+			}
+			logger, err := zap.NewDevelopment()
+			require.NoError(t, err)
+
+			handler := NewHandler(nil, testConfig.dbMock, logger)
+			user, err := handler.GetUser(context.TODO(), &pbMock.UserRequest{Id: "jl"})
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
+				assert.Equal(t, testConfig.expectedCode, status.Code(err))
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testConfig.expectedUser, user)
+			}
+			if testConfig.expectedMocks != nil {
+				testConfig.dbMock.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestHandler_SaveUser(t *testing.T) {
+	tests := map[string]struct {
+		dbMock             *database.Mock
+		userToSave         *pbMock.User
+		expectedMocks      func(t *testing.T, dbMock *database.Mock)
+		expectedErrMessage string
+	}{
+		"Successful save": {
+			dbMock:     &database.Mock{},
+			userToSave: &pbMock.User{Id: "jl"},
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("SaveUser", context.TODO(), &commonModel.User{ID: "jl"}).Return(nil)
+			},
+		},
+		"Unsuccessful save": {
+			dbMock:             &database.Mock{},
+			userToSave:         &pbMock.User{Id: "jl"},
+			expectedErrMessage: "Failed to save.",
+			expectedMocks: func(t *testing.T, dbMock *database.Mock) {
+				dbMock.On("SaveUser", context.TODO(), mock.Anything).Return(errors.New("Failed to save."))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if testConfig.expectedMocks != nil {
+				testConfig.expectedMocks(t, testConfig.dbMock)
+			}
+			logger, err := zap.NewDevelopment()
+			require.NoError(t, err)
+
+			handler := NewHandler(nil, testConfig.dbMock, logger)
+			userResponse, err := handler.SaveUser(context.TODO(), testConfig.userToSave)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualErrorf(t, err, testConfig.expectedErrMessage, "Request failed should be: %v, got: %v", testConfig.expectedErrMessage, err)
+				assert.False(t, userResponse.Success)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, userResponse.Success)
+			}
+			assert.Equal(t, testConfig.userToSave.Id, userResponse.Id)
+			if testConfig.expectedMocks != nil {
+				testConfig.dbMock.AssertExpectations(t)
+			}
 		})
 	}
 }
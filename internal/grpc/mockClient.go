@@ -11,16 +11,26 @@ type Mock struct {
 	mock.Mock
 }
 
-func (m *Mock) ListAll(ctx context.Context) ([]*model.Item, error) {
-	return handleCall(m.Called(ctx))
+func (m *Mock) ListAll(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	return handleCall(m.Called(ctx, page))
 }
 
-func (m *Mock) ListStories(ctx context.Context) ([]*model.Item, error) {
-	return handleCall(m.Called(ctx))
+func (m *Mock) ListStories(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	return handleCall(m.Called(ctx, page))
 }
 
-func (m *Mock) ListJobs(ctx context.Context) ([]*model.Item, error) {
-	return handleCall(m.Called(ctx))
+func (m *Mock) ListJobs(ctx context.Context, page model.PageRequest) (*model.ItemPage, error) {
+	return handleCall(m.Called(ctx, page))
+}
+
+func (m *Mock) Search(ctx context.Context, request model.SearchRequest) (*model.SearchPage, error) {
+	args := m.Called(ctx, request)
+
+	page, ok := args.Get(0).(*model.SearchPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return page, args.Error(1)
 }
 
 func (m *Mock) SaveItem(ctx context.Context, item *model.Item) error {
@@ -29,10 +39,42 @@ func (m *Mock) SaveItem(ctx context.Context, item *model.Item) error {
 	return args.Error(0)
 }
 
-func handleCall(args mock.Arguments) ([]*model.Item, error) {
-	itemsArgs, ok := args.Get(0).([]*model.Item)
+func (m *Mock) SaveItems(ctx context.Context, items []*model.Item) ([]error, error) {
+	args := m.Called(ctx, items)
+	itemErrs, _ := args.Get(0).([]error)
+	return itemErrs, args.Error(1)
+}
+
+func (m *Mock) GetItem(ctx context.Context, id int) (*model.Item, error) {
+	args := m.Called(ctx, id)
+
+	item, ok := args.Get(0).(*model.Item)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return item, args.Error(1)
+}
+
+func (m *Mock) GetUser(ctx context.Context, id string) (*model.User, error) {
+	args := m.Called(ctx, id)
+
+	user, ok := args.Get(0).(*model.User)
+	if !ok {
+		return nil, args.Error(1)
+	}
+	return user, args.Error(1)
+}
+
+func (m *Mock) SaveUser(ctx context.Context, user *model.User) error {
+	args := m.Called(ctx, user)
+
+	return args.Error(0)
+}
+
+func handleCall(args mock.Arguments) (*model.ItemPage, error) {
+	page, ok := args.Get(0).(*model.ItemPage)
 	if !ok {
 		return nil, args.Error(1)
 	}
-	return itemsArgs, args.Error(1)
+	return page, args.Error(1)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type Configuration struct {
 	Publisher  PublisherConfig  `mapstructure:",squash"`
 	Consumer   ConsumerConfig   `mapstructure:",squash"`
@@ -12,12 +14,31 @@ type Configuration struct {
 }
 
 type PublisherConfig struct {
-	BaseUrl      string `mapstructure:"base_url"`
-	CronSchedule string `mapstructure:"cron"`
+	BaseUrl         string        `mapstructure:"base_url"`
+	CronSchedule    string        `mapstructure:"cron"`
+	Feeds           []string      `mapstructure:"feeds"`
+	ChildDepth      int           `mapstructure:"child_depth"`
+	PublishUsers    bool          `mapstructure:"publish_users"`
+	IncrementalSync bool          `mapstructure:"incremental_sync"`
+	Workers         int           `mapstructure:"publisher_workers"`
+	RunTimeout      time.Duration `mapstructure:"publisher_run_timeout"`
+	RequestTimeout  time.Duration `mapstructure:"hn_request_timeout"`
+	MaxRetries      int           `mapstructure:"hn_max_retries"`
+	RateLimit       float64       `mapstructure:"hn_rate_limit"`
+	RateBurst       int           `mapstructure:"hn_rate_burst"`
+	MaxInFlight     int           `mapstructure:"hn_max_in_flight"`
+
+	BackfillMinID     int    `mapstructure:"backfill_min_id"`
+	BackfillSince     string `mapstructure:"backfill_since"`
+	BackfillWorkers   int    `mapstructure:"backfill_workers"`
+	BackfillBatchSize int    `mapstructure:"backfill_batch_size"`
 }
 
 type ConsumerConfig struct {
-	NumberOfWorkers int `mapstructure:"workers"`
+	NumberOfWorkers int           `mapstructure:"workers"`
+	DrainTimeout    time.Duration `mapstructure:"consumer_drain_timeout"`
+	BatchSize       int           `mapstructure:"consumer_batch_size"`
+	BatchWindow     time.Duration `mapstructure:"consumer_batch_window"`
 }
 
 type DatabaseConfig struct {
@@ -45,9 +66,16 @@ type CacheConfig struct {
 }
 
 type RabbitMqConfig struct {
-	Username  string `mapstructure:"rabbitmq_username"`
-	Password  string `mapstructure:"rabbitmq_password"`
-	Host      string `mapstructure:"rabbitmq_host"`
-	Port      string `mapstructure:"rabbitmq_port"`
-	QueueName string `mapstructure:"rabbitmq_queue_name"`
+	Backend        string        `mapstructure:"queue_backend"`
+	Username       string        `mapstructure:"rabbitmq_username"`
+	Password       string        `mapstructure:"rabbitmq_password"`
+	Host           string        `mapstructure:"rabbitmq_host"`
+	Port           string        `mapstructure:"rabbitmq_port"`
+	QueueName      string        `mapstructure:"rabbitmq_queue_name"`
+	Exchange       string        `mapstructure:"rabbitmq_exchange"`
+	BindingKeys    []string      `mapstructure:"rabbitmq_binding_keys"`
+	MaxRetries     int           `mapstructure:"rabbitmq_max_retries"`
+	RetryDelay     time.Duration `mapstructure:"rabbitmq_retry_delay"`
+	ConfirmTimeout time.Duration `mapstructure:"rabbitmq_confirm_timeout"`
+	Prefetch       int           `mapstructure:"rabbitmq_prefetch"`
 }
@@ -0,0 +1,161 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	"go.uber.org/zap"
+)
+
+const (
+	// backfillCheckpoint is the name of the checkpoint holding the lowest item id of the last completed backfill batch
+	backfillCheckpoint = "backfill"
+	// backfillFeed is the feed name carried on items published by the backfill
+	backfillFeed = "backfill"
+)
+
+// RunBackfill seeds the database with historical items by walking item ids downwards from the max item
+func RunBackfill(ctx context.Context, logger *zap.Logger, config *model.Configuration) error {
+	queueClient, err := queue.Open(logger, ctx, &config.RabbitMq)
+	if err != nil {
+		return fmt.Errorf("Unexpected error when connecting to the queue. %w", err)
+	}
+	defer queueClient.CloseConnection()
+	dbClient, err := database.New(ctx, logger, &config.Database)
+	if err != nil {
+		return fmt.Errorf("Unexpected error when connecting to the database. %w", err)
+	}
+	defer dbClient.CloseConnection(ctx)
+	service, err := NewService(logger, config, queueClient, dbClient)
+	if err != nil {
+		return fmt.Errorf("An error occurred when trying to instantiate the publisher service: %w", err)
+	}
+	return service.backfill(ctx, backfillOptions{
+		minId:     config.Publisher.BackfillMinID,
+		since:     config.Publisher.BackfillSince,
+		workers:   config.Publisher.BackfillWorkers,
+		batchSize: config.Publisher.BackfillBatchSize,
+	})
+}
+
+type backfillOptions struct {
+	// minId is the lowest item id to publish
+	minId int
+	// since stops the backfill once items created before this date (YYYY-MM-DD or RFC3339) are reached
+	since     string
+	workers   int
+	batchSize int
+}
+
+// backfill publishes items in batches from the checkpoint, or the max item when starting afresh, down to the
+// configured lower bound. The checkpoint is saved after every completed batch so an interrupted backfill resumes
+// where it left off, but never moves below an item that failed
+func (s *service) backfill(ctx context.Context, opts backfillOptions) error {
+	since, err := parseSince(opts.since)
+	if err != nil {
+		return err
+	}
+	if opts.minId < 1 {
+		opts.minId = 1
+	}
+	if opts.batchSize < 1 {
+		opts.batchSize = 100
+	}
+
+	start, err := s.dbClient.GetCheckpoint(ctx, backfillCheckpoint)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the backfill checkpoint. %w", err)
+	}
+	if start > 0 {
+		start--
+	} else {
+		start, err = s.hnClient.GetMaxItem(ctx)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve the max item. %w", err)
+		}
+	}
+	s.logger.Info("Starting backfill", zap.Int("from", start), zap.Int("min_id", opts.minId), zap.Time("since", since))
+
+	started := time.Now()
+	stats := s.newRunStats()
+	published := 0
+	// Every item from the checkpoint up has been published
+	checkpoint := start + 1
+	for high := start; high >= opts.minId; high -= opts.batchSize {
+		low := high - opts.batchSize + 1
+		if low < opts.minId {
+			low = opts.minId
+		}
+		ids := make([]int, 0, high-low+1)
+		for id := high; id >= low; id-- {
+			ids = append(ids, id)
+		}
+
+		reachedSince := false
+		mu := sync.Mutex{}
+		runPool(ctx, opts.workers, ids, func(id int) {
+			item := s.fetchItem(ctx, id, stats)
+			if item == nil {
+				return
+			}
+			// Items created before the configured date are checked before they are sent, so that none are published
+			if !since.IsZero() && time.Unix(item.Time, 0).Before(since) {
+				mu.Lock()
+				reachedSince = true
+				mu.Unlock()
+				return
+			}
+			s.sendItem(ctx, item, backfillFeed, 0, stats)
+		})
+		if ctx.Err() != nil {
+			return fmt.Errorf("Backfill interrupted before completing batch %d-%d. %w", low, high, ctx.Err())
+		}
+
+		// The checkpoint stays above the items that failed, so that a resumed backfill fetches them again
+		next := low
+		for _, id := range stats.failedIds {
+			if id >= next {
+				next = id + 1
+			}
+		}
+		if next < checkpoint {
+			if err := s.dbClient.SaveCheckpoint(ctx, backfillCheckpoint, next); err != nil {
+				return fmt.Errorf("Unable to save the backfill checkpoint. %w", err)
+			}
+			checkpoint = next
+		}
+		published += len(ids)
+		s.logger.Info("Backfill progress",
+			zap.Int("checkpoint", checkpoint),
+			zap.Int("processed", published),
+			zap.Int("remaining", low-opts.minId),
+			zap.Duration("elapsed", time.Since(started)))
+
+		if reachedSince {
+			s.logger.Info("Backfill reached items older than the configured date")
+			break
+		}
+	}
+	if len(stats.failedIds) > 0 {
+		s.logger.Warn("Backfill finished with items that failed, they are fetched again when the backfill is resumed", zap.Int("checkpoint", checkpoint))
+	}
+	s.logger.Info("Finished backfill", append(stats.fields(s.hnClient), zap.Int("processed", published), zap.Duration("elapsed", time.Since(started)))...)
+	return nil
+}
+
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, since); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Invalid backfill date %q, expected YYYY-MM-DD or RFC3339", since)
+}
@@ -0,0 +1,164 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBackfill(t *testing.T) {
+	sinceTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		opts               backfillOptions
+		expectedMocks      func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock)
+		expectedErrMessage string
+	}{
+		"Walks down from the max item in batches": {
+			opts: backfillOptions{minId: 3, workers: 2, batchSize: 2},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(0, nil)
+				hnMock.On("GetMaxItem", mock.Anything).Return(5, nil)
+				for id := 3; id <= 5; id++ {
+					hnMock.On("GetItem", mock.Anything, id).Return(&commonModel.Item{ID: id}, nil).Once()
+					queueMock.On("SendMessage", commonModel.Item{ID: id, Feed: "backfill"}).Return(nil).Once()
+				}
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 4).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 3).Return(nil).Once()
+			},
+		},
+		"Resumes below the checkpoint": {
+			opts: backfillOptions{minId: 1, workers: 2, batchSize: 10},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(3, nil)
+				for id := 1; id <= 2; id++ {
+					hnMock.On("GetItem", mock.Anything, id).Return(&commonModel.Item{ID: id}, nil).Once()
+					queueMock.On("SendMessage", commonModel.Item{ID: id, Feed: "backfill"}).Return(nil).Once()
+				}
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 1).Return(nil).Once()
+			},
+		},
+		"Stops once items are older than the configured date": {
+			opts: backfillOptions{since: "2021-01-01", workers: 1, batchSize: 1},
+			// Item 9 is older than the configured date, so it is not published
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(0, nil)
+				hnMock.On("GetMaxItem", mock.Anything).Return(10, nil)
+				hnMock.On("GetItem", mock.Anything, 10).Return(&commonModel.Item{ID: 10, Time: sinceTime.Add(time.Hour).Unix()}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 9).Return(&commonModel.Item{ID: 9, Time: sinceTime.Add(-time.Hour).Unix()}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 10, Time: sinceTime.Add(time.Hour).Unix(), Feed: "backfill"}).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 10).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 9).Return(nil).Once()
+			},
+		},
+		"Skips items that do not exist": {
+			opts: backfillOptions{minId: 1, batchSize: 10},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(0, nil)
+				hnMock.On("GetMaxItem", mock.Anything).Return(2, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(nil, fmt.Errorf("Unable to get item 2: %w", hackernews.ErrItemNotFound)).Once()
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "backfill"}).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 1).Return(nil).Once()
+			},
+		},
+		"Failed items hold the checkpoint above them": {
+			opts: backfillOptions{minId: 1, workers: 1, batchSize: 2},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(0, nil)
+				hnMock.On("GetMaxItem", mock.Anything).Return(5, nil)
+				hnMock.On("GetItem", mock.Anything, 5).Return(&commonModel.Item{ID: 5}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 4).Return(&commonModel.Item{ID: 4}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 3).Return(nil, errors.New("Failed to retrieve item")).Once()
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 5, Feed: "backfill"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 4, Feed: "backfill"}).Return(errors.New("Failed to send item")).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, Feed: "backfill"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "backfill"}).Return(nil).Once()
+				// Item 4 failed in the first batch, so the checkpoint never moves below it
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 5).Return(nil).Once()
+			},
+		},
+		"Already complete": {
+			opts: backfillOptions{minId: 5},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(5, nil)
+			},
+		},
+		"Invalid date": {
+			opts:               backfillOptions{since: "yesterday"},
+			expectedErrMessage: `Invalid backfill date "yesterday", expected YYYY-MM-DD or RFC3339`,
+			expectedMocks:      func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {},
+		},
+		"Unable to save checkpoint": {
+			opts:               backfillOptions{minId: 1, batchSize: 1},
+			expectedErrMessage: "Unable to save the backfill checkpoint. Failed",
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				dbMock.On("GetCheckpoint", context.TODO(), "backfill").Return(0, nil)
+				hnMock.On("GetMaxItem", mock.Anything).Return(1, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "backfill"}).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "backfill", 1).Return(errors.New("Failed"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			hnMock, queueMock, dbMock := &hackernews.Mock{}, &queue.Mock{}, &database.Mock{}
+			testConfig.expectedMocks(t, hnMock, queueMock, dbMock)
+			config := &model.Configuration{
+				Publisher: model.PublisherConfig{BaseUrl: "test.com"},
+			}
+			service, err := NewService(zap.NewNop(), config, queueMock, dbMock, WithHackerNewsClient(hnMock))
+			require.NoError(t, err)
+
+			err = service.backfill(context.TODO(), testConfig.opts)
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+			hnMock.AssertExpectations(t)
+			queueMock.AssertExpectations(t)
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunPool(t *testing.T) {
+	t.Run("Processes every id", func(t *testing.T) {
+		processed := make(chan int, 10)
+		runPool(context.TODO(), 3, []int{1, 2, 3, 4, 5}, func(id int) {
+			processed <- id
+		})
+		close(processed)
+
+		var ids []int
+		for id := range processed {
+			ids = append(ids, id)
+		}
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, ids)
+	})
+
+	t.Run("Stops handing out ids once cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		runPool(ctx, 1, []int{1, 2, 3}, func(id int) {
+			calls++
+			cancel()
+		})
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	"github.com/emmaLP/gs-software-onboarding/internal/queue"
 	"github.com/robfig/cron/v3"
@@ -11,27 +12,46 @@ import (
 )
 
 func ConfigureCron(ctx context.Context, logger *zap.Logger, config *model.Configuration) error {
-	c := cron.New()
+	// A run that is still going when the next is due makes the next run skip, so runs never overlap
+	c := cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))
 
 	var err error
-	queueClient, err := queue.New(logger, ctx, &config.RabbitMq)
+	queueClient, err := queue.Open(logger, ctx, &config.RabbitMq)
 	if err != nil {
 		return fmt.Errorf("Unexpected error when connecting to the database. %w", err)
 	}
 	defer queueClient.CloseConnection()
-	service, err := NewService(logger, config, queueClient)
+	dbClient, err := database.New(ctx, logger, &config.Database)
+	if err != nil {
+		return fmt.Errorf("Unexpected error when connecting to the database. %w", err)
+	}
+	defer dbClient.CloseConnection(ctx)
+	service, err := NewService(logger, config, queueClient, dbClient)
 	if err != nil {
 		return fmt.Errorf("An error occurred when trying to instantiate the publisher service: %w", err)
 	}
 	storyProcessing := func() {
-		err = service.processStories()
+		err = service.processStories(ctx)
 	}
 	storyProcessing()
 	if err != nil {
 		return fmt.Errorf("Error occurred processing stories: %w", err)
 	}
 
-	_, err = c.AddFunc(config.Publisher.CronSchedule, storyProcessing)
+	scheduledProcessing := storyProcessing
+	if config.Publisher.IncrementalSync {
+		// Record the high-water mark straight away so the first scheduled run only publishes what has changed
+		if err := service.processUpdates(ctx); err != nil {
+			return fmt.Errorf("Error occurred processing updates: %w", err)
+		}
+		scheduledProcessing = func() {
+			if err := service.processUpdates(ctx); err != nil {
+				logger.Error("Error occurred processing updates", zap.Error(err))
+			}
+		}
+	}
+
+	_, err = c.AddFunc(config.Publisher.CronSchedule, scheduledProcessing)
 	if err != nil {
 		return fmt.Errorf("Cron job error, %w", err)
 	}
@@ -0,0 +1,42 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+)
+
+// runPool calls work for each id using at most workers goroutines. Ids are no longer handed out once ctx is done,
+// but runPool always waits for the calls already in flight to return
+func runPool(ctx context.Context, workers int, ids []int, work func(id int)) {
+	if workers < 1 {
+		workers = 1
+	}
+	idChan := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range idChan {
+				if ctx.Err() != nil {
+					continue
+				}
+				work(id)
+			}
+		}()
+	}
+
+dispatch:
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case idChan <- id:
+		}
+	}
+	close(idChan)
+	wg.Wait()
+}
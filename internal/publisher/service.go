@@ -1,10 +1,17 @@
 package publisher
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
 	"go.uber.org/zap"
 )
@@ -13,24 +20,64 @@ type service struct {
 	logger      *zap.Logger
 	hnClient    hackernews.Client
 	queueClient queue.Client
+	dbClient    database.Client
+	feeds       []string
+	childDepth  int
+	workers     int
+	// runTimeout bounds how long a single run of the feeds may take
+	runTimeout time.Duration
+	// publishUsers enables publishing the profile of each item's author, once per run
+	publishUsers bool
 }
 
 type Client interface {
-	processStories()
+	processStories(ctx context.Context) error
 }
 
 type ServiceOptions func(*service)
 
-func NewService(logger *zap.Logger, config *model.Configuration, queueClient queue.Client, opts ...ServiceOptions) (*service, error) {
-	hnClient, err := hackernews.New(config.Publisher.BaseUrl, nil)
+// feedFunc retrieves the ids of the items currently listed on a Hacker News feed
+type feedFunc func(client hackernews.Client, ctx context.Context) ([]int, error)
+
+var feedFuncs = map[string]feedFunc{
+	"top":  hackernews.Client.GetTopStories,
+	"new":  hackernews.Client.GetNewStories,
+	"best": hackernews.Client.GetBestStories,
+	"ask":  hackernews.Client.GetAskStories,
+	"show": hackernews.Client.GetShowStories,
+	"job":  hackernews.Client.GetJobStories,
+}
+
+func NewService(logger *zap.Logger, config *model.Configuration, queueClient queue.Client, dbClient database.Client, opts ...ServiceOptions) (*service, error) {
+	hnClient, err := hackernews.New(config.Publisher.BaseUrl, nil,
+		hackernews.WithTimeout(config.Publisher.RequestTimeout),
+		hackernews.WithMaxRetries(config.Publisher.MaxRetries),
+		hackernews.WithRateLimit(config.Publisher.RateLimit, config.Publisher.RateBurst),
+		hackernews.WithMaxInFlight(config.Publisher.MaxInFlight))
 	if err != nil {
 		return nil, fmt.Errorf("Unable to create HackerNew client: %w", err)
 	}
 
+	feeds := config.Publisher.Feeds
+	if len(feeds) == 0 {
+		feeds = []string{"top"}
+	}
+	for _, feed := range feeds {
+		if _, ok := feedFuncs[feed]; !ok {
+			return nil, fmt.Errorf("Unsupported feed %q", feed)
+		}
+	}
+
 	service := &service{
-		logger:      logger,
-		hnClient:    hnClient,
-		queueClient: queueClient,
+		logger:       logger,
+		hnClient:     hnClient,
+		queueClient:  queueClient,
+		dbClient:     dbClient,
+		feeds:        feeds,
+		childDepth:   config.Publisher.ChildDepth,
+		workers:      config.Publisher.Workers,
+		runTimeout:   config.Publisher.RunTimeout,
+		publishUsers: config.Publisher.PublishUsers,
 	}
 
 	for _, opt := range opts {
@@ -46,31 +93,163 @@ func WithHackerNewsClient(client hackernews.Client) ServiceOptions {
 	}
 }
 
-func (s *service) processStories() error {
-	s.logger.Info("Processing stories")
-
-	storyIds, err := s.hnClient.GetTopStories()
-	if err != nil {
-		return fmt.Errorf("Unable to retrieve the top stories. %w", err)
+func (s *service) processStories(ctx context.Context) error {
+	s.logger.Info("Processing stories", zap.Strings("feeds", s.feeds))
+	if s.runTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.runTimeout)
+		defer cancel()
 	}
-	for _, id := range storyIds {
-		s.publishItem(id)
+
+	started := time.Now()
+	stats := s.newRunStats()
+	for _, feed := range s.feeds {
+		storyIds, err := feedFuncs[feed](s.hnClient, ctx)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve the %s stories. %w", feed, err)
+		}
+		runPool(ctx, s.workers, storyIds, func(id int) {
+			s.publishItem(ctx, id, feed, s.childDepth, stats)
+		})
+		if ctx.Err() != nil {
+			s.logger.Warn("Run deadline reached before all stories were processed", zap.String("feed", feed), zap.Duration("timeout", s.runTimeout))
+			break
+		}
 	}
-	s.logger.Info("Finished processing stories")
+	s.logger.Info("Finished processing stories", append(stats.fields(s.hnClient), zap.Duration("elapsed", time.Since(started)))...)
 	return nil
 }
 
-func (s *service) publishItem(storyId int) {
-	item, err := s.hnClient.GetItem(storyId)
+// runStats counts the outcome of the items handled during a run and is safe for concurrent use
+type runStats struct {
+	// fetched counts the items fetched and published
+	fetched int64
+	// skipped counts the dead, deleted or nonexistent items, which are not published
+	skipped int64
+	// failed counts the items that could not be fetched or published
+	failed int64
+	// clientStats is the Hacker News client's throttling when the run started
+	clientStats hackernews.Stats
+
+	failedMu sync.Mutex
+	// failedIds holds the ids of the items that could not be fetched or published
+	failedIds []int
+
+	usersMu sync.Mutex
+	// publishedUsers holds the ids of the users published during the run, so that each is only published once
+	publishedUsers map[string]struct{}
+}
+
+func (s *service) newRunStats() *runStats {
+	return &runStats{clientStats: clientStats(s.hnClient), publishedUsers: map[string]struct{}{}}
+}
+
+// fields summarises the run, including how long requests to Hacker News were throttled during it
+func (r *runStats) fields(client hackernews.Client) []zap.Field {
+	throttling := clientStats(client).Sub(r.clientStats)
+	return []zap.Field{
+		zap.Int64("fetched", atomic.LoadInt64(&r.fetched)),
+		zap.Int64("skipped", atomic.LoadInt64(&r.skipped)),
+		zap.Int64("failed", atomic.LoadInt64(&r.failed)),
+		zap.Int64("requests", throttling.Requests),
+		zap.Int64("throttled", throttling.Throttled),
+		zap.Duration("throttle_wait", throttling.Wait),
+	}
+}
+
+// fail records that the item could not be fetched or published
+func (r *runStats) fail(itemId int) {
+	atomic.AddInt64(&r.failed, 1)
+	r.failedMu.Lock()
+	r.failedIds = append(r.failedIds, itemId)
+	r.failedMu.Unlock()
+}
+
+func clientStats(client hackernews.Client) hackernews.Stats {
+	if reporter, ok := client.(hackernews.StatsReporter); ok {
+		return reporter.Stats()
+	}
+	return hackernews.Stats{}
+}
+
+// publishItem fetches and publishes an item, then walks its comments and poll options
+// until depth levels of children have been published
+func (s *service) publishItem(ctx context.Context, itemId int, feed string, depth int, stats *runStats) {
+	if item := s.fetchItem(ctx, itemId, stats); item != nil {
+		s.sendItem(ctx, item, feed, depth, stats)
+	}
+}
+
+// fetchItem fetches an item, returning nil if it does not exist or could not be fetched
+func (s *service) fetchItem(ctx context.Context, itemId int, stats *runStats) *commonModel.Item {
+	item, err := s.hnClient.GetItem(ctx, itemId)
+	if errors.Is(err, hackernews.ErrItemNotFound) {
+		atomic.AddInt64(&stats.skipped, 1)
+		s.logger.Debug("Skipping item that does not exist", zap.Int("id", itemId))
+		return nil
+	}
 	if err != nil {
+		stats.fail(itemId)
 		s.logger.Error("An error occurred when trying to fetch the item.", zap.Error(err))
-		return
+		return nil
 	}
+	return item
+}
 
-	if !item.Deleted && !item.Dead {
-		err := s.queueClient.SendMessage(*item)
-		if err != nil {
+// sendItem publishes a fetched item unless it is dead or deleted, then walks its children as publishItem does
+func (s *service) sendItem(ctx context.Context, item *commonModel.Item, feed string, depth int, stats *runStats) {
+	if item.Deleted || item.Dead {
+		atomic.AddInt64(&stats.skipped, 1)
+	} else {
+		item.Feed = feed
+		if err := s.queueClient.SendMessage(*item); err != nil {
+			stats.fail(item.ID)
 			s.logger.Error("Failed to send item to queue", zap.Error(err))
+		} else {
+			atomic.AddInt64(&stats.fetched, 1)
+		}
+		if s.publishUsers {
+			s.publishUser(ctx, item.CreatedBy, stats)
+		}
+	}
+
+	if depth <= 0 {
+		return
+	}
+	// Dead or deleted comments can still have live replies so their children are walked regardless
+	for _, children := range [][]int{item.Parts, item.Kids} {
+		for _, childId := range children {
+			if ctx.Err() != nil {
+				return
+			}
+			s.publishItem(ctx, childId, feed, depth-1, stats)
 		}
 	}
 }
+
+// publishUser fetches and publishes a user profile if it has not already been published during this run
+func (s *service) publishUser(ctx context.Context, userId string, stats *runStats) {
+	if userId == "" {
+		return
+	}
+	stats.usersMu.Lock()
+	_, published := stats.publishedUsers[userId]
+	stats.publishedUsers[userId] = struct{}{}
+	stats.usersMu.Unlock()
+	if published {
+		return
+	}
+
+	user, err := s.hnClient.GetUser(ctx, userId)
+	if errors.Is(err, hackernews.ErrUserNotFound) {
+		s.logger.Debug("Skipping user that does not exist", zap.String("id", userId))
+		return
+	}
+	if err != nil {
+		s.logger.Error("An error occurred when trying to fetch the user.", zap.String("id", userId), zap.Error(err))
+		return
+	}
+	if err := s.queueClient.SendUser(*user); err != nil {
+		s.logger.Error("Failed to send user to queue", zap.Error(err))
+	}
+}
@@ -1,14 +1,22 @@
 package publisher
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	"github.com/emmaLP/gs-software-onboarding/internal/queue"
 	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestProcessStories(t *testing.T) {
@@ -30,9 +38,9 @@ func TestProcessStories(t *testing.T) {
 				},
 			},
 			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
-				hnMock.On("GetTopStories").Return([]int{1}, nil)
-				hnMock.On("GetItem", 1).Return(&commonModel.Item{ID: 1}, nil)
-				queueMock.On("SendMessage", commonModel.Item{ID: 1}).Return(nil)
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "top"}).Return(nil)
 			},
 		},
 		"Two Items": {
@@ -46,11 +54,85 @@ func TestProcessStories(t *testing.T) {
 				},
 			},
 			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
-				hnMock.On("GetTopStories").Return([]int{1, 2}, nil)
-				hnMock.On("GetItem", 1).Return(&commonModel.Item{ID: 1}, nil)
-				hnMock.On("GetItem", 2).Return(&commonModel.Item{ID: 2}, nil)
-				queueMock.On("SendMessage", commonModel.Item{ID: 1}).Return(nil).Once()
-				queueMock.On("SendMessage", commonModel.Item{ID: 2}).Return(nil).Once()
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, Feed: "top"}).Return(nil).Once()
+			},
+		},
+		"Multiple feeds": {
+			hnMock:    &hackernews.Mock{},
+			queueMock: &queue.Mock{},
+			config: &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl: "test.com",
+					Feeds:   []string{"new", "job"},
+				},
+			},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetNewStories", mock.Anything).Return([]int{1}, nil)
+				hnMock.On("GetJobStories", mock.Anything).Return([]int{2}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2, Type: "job"}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "new"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, Type: "job", Feed: "job"}).Return(nil).Once()
+			},
+		},
+		"Children not fetched without depth": {
+			hnMock:    &hackernews.Mock{},
+			queueMock: &queue.Mock{},
+			config: &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl: "test.com",
+				},
+			},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1, Kids: []int{2}}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Kids: []int{2}, Feed: "top"}).Return(nil).Once()
+			},
+		},
+		"Comments and poll options fetched to configured depth": {
+			hnMock:    &hackernews.Mock{},
+			queueMock: &queue.Mock{},
+			config: &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl:    "test.com",
+					ChildDepth: 2,
+				},
+			},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1, Type: "poll", Kids: []int{2}, Parts: []int{3}}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2, Type: "comment", Parent: 1, Kids: []int{4}, Deleted: true}, nil)
+				hnMock.On("GetItem", mock.Anything, 3).Return(&commonModel.Item{ID: 3, Type: "pollopt", Poll: 1}, nil)
+				hnMock.On("GetItem", mock.Anything, 4).Return(&commonModel.Item{ID: 4, Type: "comment", Parent: 2, Kids: []int{5}}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Type: "poll", Kids: []int{2}, Parts: []int{3}, Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 3, Type: "pollopt", Poll: 1, Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 4, Type: "comment", Parent: 2, Kids: []int{5}, Feed: "top"}).Return(nil).Once()
+			},
+		},
+		"Author profiles published once per run": {
+			hnMock:    &hackernews.Mock{},
+			queueMock: &queue.Mock{},
+			config: &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl:      "test.com",
+					PublishUsers: true,
+				},
+			},
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2, 3}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1, CreatedBy: "jl"}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2, CreatedBy: "jl"}, nil)
+				hnMock.On("GetItem", mock.Anything, 3).Return(&commonModel.Item{ID: 3, CreatedBy: "pg"}, nil)
+				hnMock.On("GetUser", mock.Anything, "jl").Return(&commonModel.User{ID: "jl", Karma: 10}, nil).Once()
+				hnMock.On("GetUser", mock.Anything, "pg").Return(nil, errors.New("Failed to retrieve user")).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, CreatedBy: "jl", Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, CreatedBy: "jl", Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 3, CreatedBy: "pg", Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendUser", commonModel.User{ID: "jl", Karma: 10}).Return(nil).Once()
 			},
 		},
 		"Unable to get item from hackernews": {
@@ -64,10 +146,10 @@ func TestProcessStories(t *testing.T) {
 				},
 			},
 			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
-				hnMock.On("GetTopStories").Return([]int{1, 2}, nil)
-				hnMock.On("GetItem", 1).Return(nil, errors.New("Failed to retrieve item"))
-				hnMock.On("GetItem", 2).Return(&commonModel.Item{ID: 2}, nil)
-				queueMock.On("SendMessage", commonModel.Item{ID: 2}).Return(nil).Once()
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(nil, errors.New("Failed to retrieve item"))
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, Feed: "top"}).Return(nil).Once()
 			},
 		},
 		"Unable send item": {
@@ -81,9 +163,9 @@ func TestProcessStories(t *testing.T) {
 				},
 			},
 			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
-				hnMock.On("GetTopStories").Return([]int{2}, nil)
-				hnMock.On("GetItem", 2).Return(&commonModel.Item{ID: 2}, nil)
-				queueMock.On("SendMessage", commonModel.Item{ID: 2}).Return(errors.New("Failed to send item")).Once()
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{2}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 2, Feed: "top"}).Return(errors.New("Failed to send item")).Once()
 			},
 		},
 	}
@@ -96,12 +178,12 @@ func TestProcessStories(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			service, err := NewService(logger, testConfig.config, testConfig.queueMock, WithHackerNewsClient(testConfig.hnMock))
+			service, err := NewService(logger, testConfig.config, testConfig.queueMock, &database.Mock{}, WithHackerNewsClient(testConfig.hnMock))
 			if err != nil {
 				logger.Fatal("An unexpected err happened")
 				t.FailNow()
 			}
-			err = service.processStories()
+			err = service.processStories(context.TODO())
 			if err != nil {
 				logger.Fatal("An unexpected err happened")
 				t.FailNow()
@@ -114,3 +196,127 @@ func TestProcessStories(t *testing.T) {
 		})
 	}
 }
+
+func TestNewService(t *testing.T) {
+	tests := map[string]struct {
+		feeds              []string
+		expectedFeeds      []string
+		expectedErrMessage string
+	}{
+		"Defaults to top stories": {
+			expectedFeeds: []string{"top"},
+		},
+		"Configured feeds": {
+			feeds:         []string{"best", "ask", "show"},
+			expectedFeeds: []string{"best", "ask", "show"},
+		},
+		"Unsupported feed": {
+			feeds:              []string{"top", "unknown"},
+			expectedErrMessage: `Unsupported feed "unknown"`,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl: "test.com",
+					Feeds:   testConfig.feeds,
+				},
+			}
+			service, err := NewService(zap.NewNop(), config, &queue.Mock{}, &database.Mock{})
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+				assert.Nil(t, service)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testConfig.expectedFeeds, service.feeds)
+			}
+		})
+	}
+}
+
+func TestProcessStoriesSummary(t *testing.T) {
+	tests := map[string]struct {
+		runTimeout     time.Duration
+		expectedMocks  func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock)
+		expectedCounts map[string]int64
+	}{
+		"Counts fetched, skipped and failed items": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2, 3, 4, 5}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil)
+				hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2, Dead: true}, nil)
+				hnMock.On("GetItem", mock.Anything, 3).Return(nil, errors.New("Failed to retrieve item"))
+				hnMock.On("GetItem", mock.Anything, 4).Return(&commonModel.Item{ID: 4}, nil)
+				hnMock.On("GetItem", mock.Anything, 5).Return(nil, fmt.Errorf("Unable to get item 5: %w", hackernews.ErrItemNotFound))
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "top"}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 4, Feed: "top"}).Return(errors.New("Failed to send item")).Once()
+			},
+			expectedCounts: map[string]int64{"fetched": 1, "skipped": 2, "failed": 2},
+		},
+		"Stops dispatching items once the run deadline is reached": {
+			runTimeout: 10 * time.Millisecond,
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock) {
+				hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2}, nil)
+				hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1}, nil).After(50 * time.Millisecond)
+				queueMock.On("SendMessage", commonModel.Item{ID: 1, Feed: "top"}).Return(nil).Once()
+			},
+			expectedCounts: map[string]int64{"fetched": 1, "skipped": 0, "failed": 0},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			hnMock, queueMock := &hackernews.Mock{}, &queue.Mock{}
+			testConfig.expectedMocks(t, hnMock, queueMock)
+			core, logs := observer.New(zap.InfoLevel)
+			config := &model.Configuration{
+				Publisher: model.PublisherConfig{
+					BaseUrl:    "test.com",
+					Workers:    1,
+					RunTimeout: testConfig.runTimeout,
+				},
+			}
+			service, err := NewService(zap.New(core), config, queueMock, &database.Mock{}, WithHackerNewsClient(hnMock))
+			require.NoError(t, err)
+
+			require.NoError(t, service.processStories(context.TODO()))
+
+			summary := logs.FilterMessage("Finished processing stories").All()
+			require.Len(t, summary, 1)
+			fields := summary[0].ContextMap()
+			for name, count := range testConfig.expectedCounts {
+				assert.Equal(t, count, fields[name], name)
+			}
+			hnMock.AssertExpectations(t)
+			queueMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessStoriesOverlappingRuns(t *testing.T) {
+	hnMock, queueMock := &hackernews.Mock{}, &queue.Mock{}
+	hnMock.On("GetTopStories", mock.Anything).Return([]int{1, 2}, nil)
+	hnMock.On("GetItem", mock.Anything, 1).Return(&commonModel.Item{ID: 1, CreatedBy: "jl"}, nil)
+	hnMock.On("GetItem", mock.Anything, 2).Return(&commonModel.Item{ID: 2, CreatedBy: "jl"}, nil)
+	hnMock.On("GetUser", mock.Anything, "jl").Return(&commonModel.User{ID: "jl"}, nil)
+	queueMock.On("SendMessage", mock.Anything).Return(nil)
+	queueMock.On("SendUser", commonModel.User{ID: "jl"}).Return(nil)
+	config := &model.Configuration{
+		Publisher: model.PublisherConfig{BaseUrl: "test.com", Workers: 2, PublishUsers: true},
+	}
+	service, err := NewService(zap.NewNop(), config, queueMock, &database.Mock{}, WithHackerNewsClient(hnMock))
+	require.NoError(t, err)
+
+	// Each run keeps its own record of the users it published, so runs can overlap
+	runs := 4
+	errs := make(chan error, runs)
+	for i := 0; i < runs; i++ {
+		go func() {
+			errs <- service.processStories(context.TODO())
+		}()
+	}
+	for i := 0; i < runs; i++ {
+		require.NoError(t, <-errs)
+	}
+	queueMock.AssertNumberOfCalls(t, "SendUser", runs)
+}
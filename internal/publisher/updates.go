@@ -0,0 +1,106 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// maxItemCheckpoint is the name of the checkpoint holding the highest item id that has been published
+const maxItemCheckpoint = "maxitem"
+
+// processUpdates publishes the items and profiles that changed since the last run, along with every item created
+// since the stored high-water mark. The first run only records the high-water mark and publishes the recent updates.
+// The items are published without a feed, so that a story keeps the feed it was stored with
+func (s *service) processUpdates(ctx context.Context) error {
+	s.logger.Info("Processing updates")
+	// The high-water mark is saved with the parent context, as it is still worth saving once the run has timed out
+	runCtx := ctx
+	if s.runTimeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, s.runTimeout)
+		defer cancel()
+	}
+
+	maxItem, err := s.hnClient.GetMaxItem(runCtx)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the max item. %w", err)
+	}
+	highWaterMark, err := s.dbClient.GetCheckpoint(runCtx, maxItemCheckpoint)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the high-water mark. %w", err)
+	}
+	updates, err := s.hnClient.GetUpdates(runCtx)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the updates. %w", err)
+	}
+
+	var ids []int
+	seen := map[int]struct{}{}
+	addId := func(id int) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range updates.Items {
+		addId(id)
+	}
+	if highWaterMark > 0 {
+		for id := highWaterMark + 1; id <= maxItem; id++ {
+			addId(id)
+		}
+	}
+
+	stats := s.newRunStats()
+	processed := make(map[int]struct{}, len(ids))
+	processedMu := sync.Mutex{}
+	runPool(runCtx, s.workers, ids, func(id int) {
+		s.publishItem(runCtx, id, "", 0, stats)
+		processedMu.Lock()
+		processed[id] = struct{}{}
+		processedMu.Unlock()
+	})
+	for _, userId := range updates.Profiles {
+		if runCtx.Err() != nil {
+			break
+		}
+		s.publishUser(runCtx, userId, stats)
+	}
+
+	// Items the run did not reach before its deadline are fetched again by the next run, along with the failed items
+	unfinished := stats.failedIds
+	if runCtx.Err() != nil {
+		s.logger.Warn("Run deadline reached before all updates were processed", zap.Duration("timeout", s.runTimeout))
+		for _, id := range ids {
+			if _, ok := processed[id]; !ok {
+				unfinished = append(unfinished, id)
+			}
+		}
+	}
+	nextHighWaterMark := maxItem
+	if highWaterMark > 0 {
+		nextHighWaterMark = lowestFailedAbove(unfinished, highWaterMark, maxItem+1) - 1
+	}
+	if nextHighWaterMark > highWaterMark {
+		if err := s.dbClient.SaveCheckpoint(ctx, maxItemCheckpoint, nextHighWaterMark); err != nil {
+			return fmt.Errorf("Unable to save the high-water mark. %w", err)
+		}
+	}
+	s.logger.Info("Finished processing updates", append(stats.fields(s.hnClient), zap.Int("profiles", len(updates.Profiles)), zap.Int("max_item", maxItem), zap.Int("high_water_mark", nextHighWaterMark))...)
+	return nil
+}
+
+// lowestFailedAbove returns the lowest of the failed ids above the high-water mark, or fallback when none are. The
+// high-water mark only moves up to the first failed item, so that the next run fetches it again
+func lowestFailedAbove(failedIds []int, highWaterMark, fallback int) int {
+	lowest := fallback
+	for _, id := range failedIds {
+		if id > highWaterMark && id < lowest {
+			lowest = id
+		}
+	}
+	return lowest
+}
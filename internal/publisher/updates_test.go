@@ -0,0 +1,153 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/database"
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/emmaLP/gs-software-onboarding/internal/queue"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestProcessUpdates(t *testing.T) {
+	tests := map[string]struct {
+		expectedMocks      func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock)
+		expectedErrMessage string
+	}{
+		"First run records the high-water mark": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(100, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(0, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{Items: []int{50}}, nil)
+				hnMock.On("GetItem", mock.Anything, 50).Return(&commonModel.Item{ID: 50}, nil)
+				queueMock.On("SendMessage", commonModel.Item{ID: 50}).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "maxitem", 100).Return(nil).Once()
+			},
+		},
+		"New and changed items and profiles published": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(102, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(100, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{Items: []int{50, 101}, Profiles: []string{"jl"}}, nil)
+				hnMock.On("GetItem", mock.Anything, 50).Return(&commonModel.Item{ID: 50}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 101).Return(&commonModel.Item{ID: 101}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 102).Return(&commonModel.Item{ID: 102, Dead: true}, nil).Once()
+				hnMock.On("GetUser", mock.Anything, "jl").Return(&commonModel.User{ID: "jl"}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 50}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 101}).Return(nil).Once()
+				queueMock.On("SendUser", commonModel.User{ID: "jl"}).Return(nil).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "maxitem", 102).Return(nil).Once()
+			},
+		},
+		"High-water mark stops below the first failed item": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(104, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(100, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{}, nil)
+				hnMock.On("GetItem", mock.Anything, 101).Return(&commonModel.Item{ID: 101}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 102).Return(nil, errors.New("Failed")).Once()
+				hnMock.On("GetItem", mock.Anything, 103).Return(&commonModel.Item{ID: 103}, nil).Once()
+				hnMock.On("GetItem", mock.Anything, 104).Return(&commonModel.Item{ID: 104}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 101}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 103}).Return(nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 104}).Return(errors.New("Failed")).Once()
+				dbMock.On("SaveCheckpoint", context.TODO(), "maxitem", 101).Return(nil).Once()
+			},
+		},
+		"High-water mark is left when the first new item fails": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(102, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(100, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{Items: []int{50}}, nil)
+				hnMock.On("GetItem", mock.Anything, 50).Return(nil, errors.New("Failed")).Once()
+				hnMock.On("GetItem", mock.Anything, 101).Return(nil, errors.New("Failed")).Once()
+				hnMock.On("GetItem", mock.Anything, 102).Return(&commonModel.Item{ID: 102}, nil).Once()
+				queueMock.On("SendMessage", commonModel.Item{ID: 102}).Return(nil).Once()
+			},
+		},
+		"Unchanged max item leaves the high-water mark": {
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(100, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(100, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{}, nil)
+			},
+		},
+		"Unable to get max item": {
+			expectedErrMessage: "Unable to retrieve the max item. Failed",
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(0, errors.New("Failed"))
+			},
+		},
+		"Unable to get checkpoint": {
+			expectedErrMessage: "Unable to retrieve the high-water mark. Failed",
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(100, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(0, errors.New("Failed"))
+			},
+		},
+		"Unable to save checkpoint": {
+			expectedErrMessage: "Unable to save the high-water mark. Failed",
+			expectedMocks: func(t *testing.T, hnMock *hackernews.Mock, queueMock *queue.Mock, dbMock *database.Mock) {
+				hnMock.On("GetMaxItem", mock.Anything).Return(100, nil)
+				dbMock.On("GetCheckpoint", context.TODO(), "maxitem").Return(0, nil)
+				hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{}, nil)
+				dbMock.On("SaveCheckpoint", context.TODO(), "maxitem", 100).Return(errors.New("Failed"))
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			hnMock, queueMock, dbMock := &hackernews.Mock{}, &queue.Mock{}, &database.Mock{}
+			testConfig.expectedMocks(t, hnMock, queueMock, dbMock)
+			config := &model.Configuration{
+				Publisher: model.PublisherConfig{BaseUrl: "test.com"},
+			}
+			service, err := NewService(zap.NewNop(), config, queueMock, dbMock, WithHackerNewsClient(hnMock))
+			require.NoError(t, err)
+
+			err = service.processUpdates(context.TODO())
+			if testConfig.expectedErrMessage != "" {
+				assert.EqualError(t, err, testConfig.expectedErrMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+			hnMock.AssertExpectations(t)
+			queueMock.AssertExpectations(t)
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessUpdatesTimeout(t *testing.T) {
+	hnMock, queueMock, dbMock := &hackernews.Mock{}, &queue.Mock{}, &database.Mock{}
+	hnMock.On("GetMaxItem", mock.Anything).Return(103, nil)
+	dbMock.On("GetCheckpoint", mock.Anything, "maxitem").Return(100, nil)
+	hnMock.On("GetUpdates", mock.Anything).Return(&commonModel.Updates{}, nil)
+	hnMock.On("GetItem", mock.Anything, 101).Return(&commonModel.Item{ID: 101}, nil).Once()
+	hnMock.On("GetItem", mock.Anything, 102).Return(nil, context.DeadlineExceeded).Once().
+		Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		})
+	queueMock.On("SendMessage", commonModel.Item{ID: 101}).Return(nil).Once()
+	// The high-water mark is saved once the run has timed out, stopping below the items it did not finish
+	dbMock.On("SaveCheckpoint", context.TODO(), "maxitem", 101).Return(nil).Once()
+
+	config := &model.Configuration{
+		Publisher: model.PublisherConfig{BaseUrl: "test.com", RunTimeout: 50 * time.Millisecond},
+	}
+	service, err := NewService(zap.NewNop(), config, queueMock, dbMock, WithHackerNewsClient(hnMock))
+	require.NoError(t, err)
+
+	require.NoError(t, service.processUpdates(context.TODO()))
+	hnMock.AssertExpectations(t)
+	queueMock.AssertExpectations(t)
+	dbMock.AssertExpectations(t)
+}
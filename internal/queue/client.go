@@ -2,9 +2,11 @@ package queue
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/url"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/internal/model"
 	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
@@ -13,125 +15,290 @@ import (
 )
 
 type client struct {
-	logger      *zap.Logger
-	amqpConn    *amqp.Connection
-	queue       amqp.Queue
-	amqpChannel *amqp.Channel
+	logger     *zap.Logger
+	ctx        context.Context
+	amqpConfig *model.RabbitMqConfig
+	topology   topology
+	maxRetries int
+	// consumerTag identifies the consumer so that it can be cancelled on shutdown
+	consumerTag string
+
+	// mu guards the connection, which is nil while reconnecting. connected is closed once a connection is available
+	mu        sync.Mutex
+	conn      *connection
+	connected chan struct{}
+	closing   bool
+
+	// publishMu serialises publishes so confirmations arrive in the order they are awaited
+	publishMu      sync.Mutex
+	confirmTimeout time.Duration
 }
 
 type Client interface {
 	SendMessage(item commonModel.Item) error
-	ReceiveMessage(msgChan chan *commonModel.Item) error
+	SendUser(user commonModel.User) error
+	ReceiveMessage(msgChan chan *Message) error
 	CloseConnection()
 }
 
-func New(logger *zap.Logger, ctx context.Context, amqpConfig *model.RabbitMqConfig) (*client, error) {
-	amqpConn, err := connect(ctx, logger, amqpConfig)
-	if err != nil {
-		return nil, fmt.Errorf("Unable to connect to rabbitmq. %w", err)
+// Message is a message read off the queue holding either an item or a user profile. It must be acknowledged once it
+// has been persisted, or negatively acknowledged if it could not be, so that it is not lost
+type Message struct {
+	// ID and ProducedAt are read from the envelope, and are empty for legacy JSON messages
+	ID         string
+	ProducedAt time.Time
+	Item       *commonModel.Item
+	User       *commonModel.User
+
+	ack   func() error
+	nack  func(requeue bool) error
+	retry func() error
+}
+
+// Ack acknowledges the message, removing it from the queue
+func (m *Message) Ack() error {
+	if m.ack == nil {
+		return nil
 	}
+	return m.ack()
+}
 
-	channel, err := amqpConn.Channel()
-	if err != nil {
-		return nil, fmt.Errorf("Unable to create channel. %w", err)
+// Nack negatively acknowledges the message. The message is redelivered when requeue is true, otherwise it is
+// dead-lettered
+func (m *Message) Nack(requeue bool) error {
+	if m.nack == nil {
+		return nil
 	}
+	return m.nack(requeue)
+}
 
-	queue, err := channel.QueueDeclare(
-		amqpConfig.QueueName,
-		false, // durable
-		false, // delete when unused
-		false, // exclusive
-		false, // no-wait
-		nil,   // arguments
-	)
+// Retry schedules the message to be redelivered after the retry delay, or dead-letters it once it has been retried
+// the maximum number of times
+func (m *Message) Retry() error {
+	if m.retry == nil {
+		return nil
+	}
+	return m.retry()
+}
+
+const (
+	RabbitMQBackend = "rabbitmq"
+	MemoryBackend   = "memory"
+)
+
+const (
+	itemMessageType = "item"
+	userMessageType = "user"
+)
+
+// New connects to RabbitMQ and declares the topology. The connection is reopened whenever it is lost until ctx is done
+// or the connection is closed
+func New(logger *zap.Logger, ctx context.Context, amqpConfig *model.RabbitMqConfig) (*client, error) {
+	topology := newTopology(amqpConfig)
+	conn, err := open(ctx, logger, amqpConfig, topology)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to declare queue. %w", err)
+		return nil, err
 	}
 
-	return &client{
-		logger:      logger,
-		amqpConn:    amqpConn,
-		amqpChannel: channel,
-		queue:       queue,
-	}, nil
+	c := &client{
+		logger:         logger,
+		ctx:            ctx,
+		amqpConfig:     amqpConfig,
+		topology:       topology,
+		maxRetries:     amqpConfig.MaxRetries,
+		consumerTag:    newConsumerTag(),
+		connected:      make(chan struct{}),
+		confirmTimeout: amqpConfig.ConfirmTimeout,
+	}
+	c.setConnection(conn)
+	go c.watch(conn)
+	return c, nil
 }
 
-func connect(ctx context.Context, logger *zap.Logger, amqpConfig *model.RabbitMqConfig) (*amqp.Connection, error) {
-	amqpUrl := fmt.Sprintf("amqp://%s:%s@%s:%s/",
-		url.QueryEscape(amqpConfig.Username),
-		url.QueryEscape(amqpConfig.Password),
-		url.QueryEscape(amqpConfig.Host),
-		url.QueryEscape(amqpConfig.Port))
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to connect to RabbitMQ: %v", ctx.Err())
-		default:
-			conn, err := amqp.Dial(amqpUrl)
-			if err != nil {
-				logger.Error("Unable to establish connection to RabbitMQ", zap.Error(err))
-				break
-			}
-			return conn, nil
+// Open returns the client for the configured backend, either RabbitMQ or the in-memory queue
+func Open(logger *zap.Logger, ctx context.Context, amqpConfig *model.RabbitMqConfig) (Client, error) {
+	switch amqpConfig.Backend {
+	case RabbitMQBackend, "":
+		c, err := New(logger, ctx, amqpConfig)
+		if err != nil {
+			return nil, err
 		}
+		return c, nil
+	case MemoryBackend:
+		return NewMemory(logger, ctx, amqpConfig), nil
+	default:
+		return nil, fmt.Errorf("Unsupported queue backend %q", amqpConfig.Backend)
 	}
 }
 
 func (c *client) SendMessage(item commonModel.Item) error {
-	body, err := json.Marshal(item)
+	msg, err := newItemPublishing(item)
 	if err != nil {
-		return fmt.Errorf("Failed to marshel item to json: %w", err)
-	}
-	err = c.amqpChannel.Publish(
-		"",           // exchange
-		c.queue.Name, // routing key
-		false,        // mandatory
-		false,        // immediate
-		amqp.Publishing{
-			ContentType: "text/plain",
-			Body:        body,
-		})
+		return err
+	}
+	err = c.publishConfirmed(c.topology.exchange, itemRoutingKey(item), msg)
 	if err == nil {
 		c.logger.Info("Item successfully pushed to queue")
 	}
 	return err
 }
 
-func (c *client) ReceiveMessage(msgChan chan *commonModel.Item) error {
-	messages, err := c.amqpChannel.Consume(
-		c.queue.Name,
-		"",
-		false,
-		false,
-		false,
-		false,
-		nil,
-	)
+func (c *client) SendUser(user commonModel.User) error {
+	msg, err := newUserPublishing(user)
 	if err != nil {
-		return fmt.Errorf("Consuming messages: %w.", err)
+		return err
+	}
+	err = c.publishConfirmed(c.topology.exchange, userRoutingKey, msg)
+	if err == nil {
+		c.logger.Info("User successfully pushed to queue")
 	}
-	for message := range messages {
-		item := commonModel.Item{}
-		err := json.Unmarshal(message.Body, &item)
+	return err
+}
+
+// ReceiveMessage pushes the messages read off the queue onto msgChan. Consuming resumes after the connection is
+// restored, and ReceiveMessage only returns once the client is closed or its context is done. When the context is done
+// the consumer is cancelled, and ReceiveMessage returns once the messages already delivered have been pushed onto msgChan
+func (c *client) ReceiveMessage(msgChan chan *Message) error {
+	for {
+		conn, err := c.waitForConnection(0)
 		if err != nil {
-			c.logger.Error("Unable to unmarshal message body", zap.ByteString("message_body", message.Body), zap.Error(err))
-			continue
+			if c.isClosing() || c.ctx.Err() != nil {
+				return nil
+			}
+			return err
 		}
-		if err := message.Ack(false); err != nil {
-			c.logger.Error("Unable to acknowledge message", zap.Error(err))
+
+		messages, err := conn.amqpChannel.Consume(
+			c.topology.queue,
+			c.consumerTag,
+			false,
+			false,
+			false,
+			false,
+			nil,
+		)
+		if err != nil {
+			if c.isClosing() {
+				return nil
+			}
+			// The channel closed between reconnecting and consuming, so wait for the next connection
+			c.logger.Warn("Unable to consume messages, waiting for the connection to be restored", zap.Error(err))
+			c.waitForReconnect(conn)
 			continue
 		}
-		msgChan <- &item
+		c.logger.Info("Consuming messages", zap.String("queue", c.topology.queue))
+
+		consuming := make(chan struct{})
+		go c.cancelOnDone(conn, consuming)
+		for message := range messages {
+			c.deliver(message, msgChan)
+		}
+		close(consuming)
+		if c.isClosing() || c.ctx.Err() != nil {
+			return nil
+		}
+		c.logger.Warn("Stopped receiving messages, waiting for the connection to be restored")
+		c.waitForReconnect(conn)
 	}
+}
 
-	return nil
+// cancelOnDone cancels the consumer once the client's context is done so that RabbitMQ stops delivering messages. The
+// deliveries channel is closed once the messages already delivered have been read
+func (c *client) cancelOnDone(conn *connection, consuming <-chan struct{}) {
+	select {
+	case <-c.ctx.Done():
+		c.logger.Info("Cancelling consumer", zap.String("consumer_tag", c.consumerTag))
+		if err := conn.amqpChannel.Cancel(c.consumerTag, false); err != nil && !errors.Is(err, amqp.ErrClosed) {
+			c.logger.Error("Unable to cancel consumer", zap.Error(err))
+		}
+	case <-consuming:
+	}
 }
 
-func (c *client) CloseConnection() {
-	if err := c.amqpChannel.Close(); err != nil {
-		c.logger.Error("Unable to close channel", zap.Error(err))
+// waitForReconnect blocks until conn has been replaced by a new connection or the client's context is done
+func (c *client) waitForReconnect(conn *connection) {
+	for {
+		c.mu.Lock()
+		current, connected := c.conn, c.connected
+		c.mu.Unlock()
+		if current != nil && current != conn {
+			return
+		}
+
+		// While conn is still current the loss has not been noticed yet, so check again shortly
+		wait := time.After(100 * time.Millisecond)
+		if current == nil {
+			wait = nil
+		}
+		select {
+		case <-connected:
+		case <-wait:
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *client) deliver(message amqp.Delivery, msgChan chan *Message) {
+	msg, err := decodeMessage(message)
+	if err != nil {
+		c.logger.Error("Unable to decode message",
+			zap.String("content_type", message.ContentType), zap.ByteString("message_body", message.Body), zap.Error(err))
+		// The message can never be decoded so it is dead-lettered rather than redelivered
+		if err := message.Nack(false, false); err != nil {
+			c.logger.Error("Unable to reject message", zap.Error(err))
+		}
+		return
 	}
-	if err := c.amqpConn.Close(); err != nil {
-		c.logger.Error("Unable to close rabbitmq connection", zap.Error(err))
+	msg.ack = func() error {
+		return message.Ack(false)
+	}
+	msg.nack = func(requeue bool) error {
+		return message.Nack(false, requeue)
+	}
+	msg.retry = func() error {
+		return c.retry(message)
+	}
+	msgChan <- msg
+}
+
+// retry republishes the delivery to the retry queue with its retry count incremented, from where it returns to the
+// queue once the retry delay has passed. Deliveries that have reached the max retries are dead-lettered instead
+func (c *client) retry(delivery amqp.Delivery) error {
+	retries := retryCount(delivery.Headers)
+	if retries >= c.maxRetries {
+		c.logger.Warn("Message reached the max retries, dead-lettering it", zap.Int("retries", retries))
+		return delivery.Nack(false, false)
+	}
+
+	headers := amqp.Table{}
+	for k, v := range delivery.Headers {
+		headers[k] = v
+	}
+	headers[retryCountHeader] = int32(retries + 1)
+	// Retries go through the default exchange so that only this queue receives the message again
+	err := c.publishConfirmed("", c.topology.retryQueue, republishing(delivery, headers))
+	if err != nil {
+		return fmt.Errorf("Unable to publish message to the retry queue. %w", err)
+	}
+	return delivery.Ack(false)
+}
+
+// newConsumerTag returns a tag that identifies this process in the RabbitMQ management UI
+func newConsumerTag() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "consumer"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+func (c *client) CloseConnection() {
+	c.mu.Lock()
+	c.closing = true
+	conn := c.conn
+	c.mu.Unlock()
+	if conn != nil {
+		conn.close(c.logger)
 	}
 }
@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// ErrNotConfirmed is returned when the broker does not confirm that it has taken responsibility for a message
+var ErrNotConfirmed = errors.New("message not confirmed by the broker")
+
+// publishConfirmed publishes a persistent message and waits for the broker to confirm it. Publishes are serialised so
+// that each confirmation can be matched to its message
+func (c *client) publishConfirmed(exchange, routingKey string, msg amqp.Publishing) error {
+	msg.DeliveryMode = amqp.Persistent
+
+	// Publishing during an outage waits for the connection to be restored, failing if it is not restored in time
+	conn, err := c.waitForConnection(c.confirmTimeout)
+	if err != nil {
+		return err
+	}
+
+	c.publishMu.Lock()
+	defer c.publishMu.Unlock()
+	err = conn.amqpChannel.Publish(
+		exchange,   // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
+		msg)
+	if err != nil {
+		return err
+	}
+	conn.deliveryTag++
+	return awaitConfirm(conn.confirms, conn.deliveryTag, c.confirmTimeout)
+}
+
+// awaitConfirm waits for the confirmation of deliveryTag, without a time limit when timeout is zero. Confirmations of
+// earlier messages, which arrive after their publish timed out, are discarded
+func awaitConfirm(confirms <-chan amqp.Confirmation, deliveryTag uint64, timeout time.Duration) error {
+	var timedOut <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timedOut = timer.C
+	}
+	for {
+		select {
+		case confirm, ok := <-confirms:
+			if !ok {
+				return fmt.Errorf("Channel closed before the message was confirmed. %w", ErrNotConfirmed)
+			}
+			if confirm.DeliveryTag < deliveryTag {
+				continue
+			}
+			if !confirm.Ack {
+				return fmt.Errorf("Broker rejected the message. %w", ErrNotConfirmed)
+			}
+			return nil
+		case <-timedOut:
+			return fmt.Errorf("Timed out after %s waiting for confirmation. %w", timeout, ErrNotConfirmed)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwaitConfirm(t *testing.T) {
+	tests := map[string]struct {
+		confirms    []amqp.Confirmation
+		close       bool
+		expectedErr string
+	}{
+		"Confirmed": {
+			confirms: []amqp.Confirmation{{DeliveryTag: 2, Ack: true}},
+		},
+		"Late confirmations of earlier messages are discarded": {
+			confirms: []amqp.Confirmation{{DeliveryTag: 1, Ack: false}, {DeliveryTag: 2, Ack: true}},
+		},
+		"Rejected": {
+			confirms:    []amqp.Confirmation{{DeliveryTag: 2, Ack: false}},
+			expectedErr: "Broker rejected the message. message not confirmed by the broker",
+		},
+		"Timed out": {
+			confirms:    []amqp.Confirmation{{DeliveryTag: 1, Ack: true}},
+			expectedErr: "Timed out after 10ms waiting for confirmation. message not confirmed by the broker",
+		},
+		"Channel closed": {
+			close:       true,
+			expectedErr: "Channel closed before the message was confirmed. message not confirmed by the broker",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			confirms := make(chan amqp.Confirmation, len(testConfig.confirms))
+			for _, confirm := range testConfig.confirms {
+				confirms <- confirm
+			}
+			if testConfig.close {
+				close(confirms)
+			}
+
+			err := awaitConfirm(confirms, 2, 10*time.Millisecond)
+			if testConfig.expectedErr != "" {
+				assert.EqualError(t, err, testConfig.expectedErr)
+				assert.ErrorIs(t, err, ErrNotConfirmed)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
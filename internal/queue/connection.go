@@ -0,0 +1,194 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// ErrNotConnected is returned when RabbitMQ cannot be reached before the operation times out
+var ErrNotConnected = errors.New("not connected to rabbitmq")
+
+const (
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+)
+
+// connection is an open connection and channel with the topology declared and publisher confirms enabled
+type connection struct {
+	amqpConn    *amqp.Connection
+	amqpChannel *amqp.Channel
+	confirms    chan amqp.Confirmation
+	// deliveryTag is the tag of the last message published on the channel, guarded by the client's publishMu
+	deliveryTag uint64
+}
+
+func (c *connection) close(logger *zap.Logger) {
+	if err := c.amqpChannel.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		logger.Error("Unable to close channel", zap.Error(err))
+	}
+	if err := c.amqpConn.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		logger.Error("Unable to close rabbitmq connection", zap.Error(err))
+	}
+}
+
+// open dials RabbitMQ, retrying with backoff until it succeeds or ctx is done, then declares the topology on a new
+// channel
+func open(ctx context.Context, logger *zap.Logger, amqpConfig *model.RabbitMqConfig, topology topology) (*connection, error) {
+	amqpConn, err := connect(ctx, logger, amqpConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to connect to rabbitmq. %w", err)
+	}
+
+	channel, err := amqpConn.Channel()
+	if err != nil {
+		amqpConn.Close()
+		return nil, fmt.Errorf("Unable to create channel. %w", err)
+	}
+	conn := &connection{amqpConn: amqpConn, amqpChannel: channel}
+
+	// The prefetch limits how many unacknowledged messages RabbitMQ delivers to this consumer at once
+	if amqpConfig.Prefetch > 0 {
+		if err := channel.Qos(amqpConfig.Prefetch, 0, false); err != nil {
+			conn.close(logger)
+			return nil, fmt.Errorf("Unable to set the prefetch count. %w", err)
+		}
+	}
+
+	if _, err := topology.declare(channel); err != nil {
+		conn.close(logger)
+		return nil, err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		conn.close(logger)
+		return nil, fmt.Errorf("Unable to put channel into confirm mode. %w", err)
+	}
+	conn.confirms = channel.NotifyPublish(make(chan amqp.Confirmation, 16))
+	return conn, nil
+}
+
+func connect(ctx context.Context, logger *zap.Logger, amqpConfig *model.RabbitMqConfig) (*amqp.Connection, error) {
+	amqpUrl := fmt.Sprintf("amqp://%s:%s@%s:%s/",
+		url.QueryEscape(amqpConfig.Username),
+		url.QueryEscape(amqpConfig.Password),
+		url.QueryEscape(amqpConfig.Host),
+		url.QueryEscape(amqpConfig.Port))
+	for attempt := 0; ; attempt++ {
+		conn, err := amqp.Dial(amqpUrl)
+		if err == nil {
+			return conn, nil
+		}
+
+		delay := reconnectDelay(attempt)
+		logger.Error("Unable to establish connection to RabbitMQ", zap.Error(err), zap.Duration("retry_in", delay))
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("failed to connect to RabbitMQ: %v", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// reconnectDelay doubles the delay for each failed attempt up to maxReconnectDelay, adding up to 20% jitter so that
+// clients do not reconnect in lockstep
+func reconnectDelay(attempt int) time.Duration {
+	delay := minReconnectDelay
+	for i := 0; i < attempt && delay < maxReconnectDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxReconnectDelay {
+		delay = maxReconnectDelay
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+// watch reopens the connection whenever the connection or channel closes unexpectedly, until the client is closed
+func (c *client) watch(conn *connection) {
+	for {
+		connClosed := conn.amqpConn.NotifyClose(make(chan *amqp.Error, 1))
+		channelClosed := conn.amqpChannel.NotifyClose(make(chan *amqp.Error, 1))
+
+		var reason *amqp.Error
+		select {
+		case reason = <-connClosed:
+		case reason = <-channelClosed:
+		case <-c.ctx.Done():
+			return
+		}
+		if c.isClosing() {
+			return
+		}
+
+		c.logger.Warn("RabbitMQ connection lost, reconnecting", zap.Error(reason))
+		c.setConnection(nil)
+		conn.close(c.logger)
+
+		newConn, err := open(c.ctx, c.logger, c.amqpConfig, c.topology)
+		if err != nil {
+			c.logger.Error("Gave up reconnecting to RabbitMQ", zap.Error(err))
+			return
+		}
+		conn = newConn
+		c.setConnection(conn)
+		c.logger.Info("RabbitMQ connection restored")
+	}
+}
+
+// setConnection replaces the current connection. A nil connection marks the client as disconnected, so callers wait
+// for the next connection
+func (c *client) setConnection(conn *connection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.conn = conn
+	if conn != nil {
+		close(c.connected)
+	} else {
+		c.connected = make(chan struct{})
+	}
+}
+
+func (c *client) isClosing() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closing
+}
+
+// waitForConnection returns the current connection, waiting up to timeout for the client to reconnect if it is
+// disconnected. A timeout of zero waits until the client's context is done
+func (c *client) waitForConnection(timeout time.Duration) (*connection, error) {
+	var timedOut <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timedOut = timer.C
+	}
+	for {
+		c.mu.Lock()
+		conn, connected, closing := c.conn, c.connected, c.closing
+		c.mu.Unlock()
+		if closing {
+			return nil, fmt.Errorf("Client is closed. %w", ErrNotConnected)
+		}
+		if conn != nil {
+			return conn, nil
+		}
+
+		select {
+		case <-connected:
+		case <-timedOut:
+			return nil, fmt.Errorf("Timed out after %s waiting to reconnect. %w", timeout, ErrNotConnected)
+		case <-c.ctx.Done():
+			return nil, fmt.Errorf("%v. %w", c.ctx.Err(), ErrNotConnected)
+		}
+	}
+}
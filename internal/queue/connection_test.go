@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReconnectDelay(t *testing.T) {
+	tests := map[string]struct {
+		attempt  int
+		expected time.Duration
+	}{
+		"First attempt":  {attempt: 0, expected: minReconnectDelay},
+		"Doubles":        {attempt: 2, expected: 4 * minReconnectDelay},
+		"Capped":         {attempt: 20, expected: maxReconnectDelay},
+		"Capped forever": {attempt: 1000, expected: maxReconnectDelay},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			delay := reconnectDelay(testConfig.attempt)
+			assert.GreaterOrEqual(t, delay, testConfig.expected)
+			assert.LessOrEqual(t, delay, testConfig.expected+testConfig.expected/5)
+		})
+	}
+}
+
+func TestWaitForConnection(t *testing.T) {
+	newClient := func(ctx context.Context) *client {
+		return &client{logger: zap.NewNop(), ctx: ctx, connected: make(chan struct{})}
+	}
+
+	t.Run("Returns the current connection", func(t *testing.T) {
+		c := newClient(context.TODO())
+		conn := &connection{}
+		c.setConnection(conn)
+
+		current, err := c.waitForConnection(time.Millisecond)
+		assert.NoError(t, err)
+		assert.Same(t, conn, current)
+	})
+
+	t.Run("Waits for the connection to be restored", func(t *testing.T) {
+		c := newClient(context.TODO())
+		conn := &connection{}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			c.setConnection(conn)
+		}()
+
+		current, err := c.waitForConnection(time.Second)
+		assert.NoError(t, err)
+		assert.Same(t, conn, current)
+	})
+
+	t.Run("Times out while disconnected", func(t *testing.T) {
+		c := newClient(context.TODO())
+
+		_, err := c.waitForConnection(10 * time.Millisecond)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotConnected)
+	})
+
+	t.Run("Stops waiting once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		c := newClient(ctx)
+		cancel()
+
+		_, err := c.waitForConnection(0)
+		assert.ErrorIs(t, err, ErrNotConnected)
+	})
+
+	t.Run("Closed client", func(t *testing.T) {
+		c := newClient(context.TODO())
+		c.CloseConnection()
+
+		_, err := c.waitForConnection(0)
+		assert.EqualError(t, err, "Client is closed. not connected to rabbitmq")
+	})
+}
@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"fmt"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// DeadLetter is a message that was dead-lettered after it could not be decoded or saved
+type DeadLetter struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Retries int    `json:"retries"`
+	// Reason is why RabbitMQ dead-lettered the message, such as rejected
+	Reason string            `json:"reason"`
+	Item   *commonModel.Item `json:"item,omitempty"`
+	User   *commonModel.User `json:"user,omitempty"`
+	// Body is only set for messages that cannot be decoded
+	Body string `json:"body,omitempty"`
+}
+
+// ListDeadLetters returns up to limit dead-lettered messages, or all of them when limit is zero, leaving them on
+// the dead-letter queue
+func (c *client) ListDeadLetters(limit int) ([]DeadLetter, error) {
+	deliveries, err := c.getDeadLetters(limit)
+	if len(deliveries) > 0 {
+		// Requeue everything fetched in one go, requeueing one at a time would fetch the same message again
+		if nackErr := deliveries[len(deliveries)-1].Nack(true, true); nackErr != nil && err == nil {
+			err = fmt.Errorf("Unable to return messages to the dead-letter queue. %w", nackErr)
+		}
+	}
+
+	deadLetters := make([]DeadLetter, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deadLetters = append(deadLetters, newDeadLetter(delivery))
+	}
+	return deadLetters, err
+}
+
+// ReplayDeadLetters moves up to limit dead-lettered messages, or all of them when limit is zero, back onto the queue
+// with their retry count reset. It returns the number of messages replayed
+func (c *client) ReplayDeadLetters(limit int) (int, error) {
+	// Replaying is bounded by the messages already dead-lettered, so messages that are dead-lettered again while
+	// replaying are not replayed in a loop
+	limit, err := c.deadLetterLimit(limit)
+	if err != nil {
+		return 0, err
+	}
+	replayed := 0
+	for replayed < limit {
+		conn, err := c.waitForConnection(c.confirmTimeout)
+		if err != nil {
+			return replayed, err
+		}
+		delivery, ok, err := conn.amqpChannel.Get(c.topology.deadLetterQueue, false)
+		if err != nil {
+			return replayed, fmt.Errorf("Unable to get message from the dead-letter queue. %w", err)
+		}
+		if !ok {
+			break
+		}
+
+		headers := amqp.Table{}
+		for k, v := range delivery.Headers {
+			if k != retryCountHeader && k != "x-death" {
+				headers[k] = v
+			}
+		}
+		err = c.publishConfirmed("", c.topology.queue, republishing(delivery, headers))
+		if err != nil {
+			if nackErr := delivery.Nack(false, true); nackErr != nil {
+				c.logger.Error("Unable to return message to the dead-letter queue", zap.Error(nackErr))
+			}
+			return replayed, fmt.Errorf("Unable to replay message. %w", err)
+		}
+		if err := delivery.Ack(false); err != nil {
+			return replayed, fmt.Errorf("Unable to remove replayed message from the dead-letter queue. %w", err)
+		}
+		replayed++
+	}
+	return replayed, nil
+}
+
+func newDeadLetter(delivery amqp.Delivery) DeadLetter {
+	deadLetter := DeadLetter{
+		ID:      delivery.MessageId,
+		Type:    delivery.Type,
+		Retries: retryCount(delivery.Headers),
+		Reason:  deathReason(delivery.Headers),
+	}
+	msg, err := decodeMessage(delivery)
+	if err != nil {
+		deadLetter.Body = string(delivery.Body)
+		return deadLetter
+	}
+	deadLetter.Item = msg.Item
+	deadLetter.User = msg.User
+	return deadLetter
+}
+
+func (c *client) getDeadLetters(limit int) ([]amqp.Delivery, error) {
+	limit, err := c.deadLetterLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.waitForConnection(c.confirmTimeout)
+	if err != nil {
+		return nil, err
+	}
+	var deliveries []amqp.Delivery
+	for len(deliveries) < limit {
+		delivery, ok, err := conn.amqpChannel.Get(c.topology.deadLetterQueue, false)
+		if err != nil {
+			return deliveries, fmt.Errorf("Unable to get message from the dead-letter queue. %w", err)
+		}
+		if !ok {
+			break
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// deadLetterLimit caps limit at the number of messages on the dead-letter queue, which is used when limit is zero
+func (c *client) deadLetterLimit(limit int) (int, error) {
+	conn, err := c.waitForConnection(c.confirmTimeout)
+	if err != nil {
+		return 0, err
+	}
+	queue, err := conn.amqpChannel.QueueInspect(c.topology.deadLetterQueue)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to inspect the dead-letter queue. %w", err)
+	}
+	if limit <= 0 || limit > queue.Messages {
+		return queue.Messages, nil
+	}
+	return limit, nil
+}
+
+// deathReason reads the reason of the most recent death from the x-death header set by RabbitMQ
+func deathReason(headers amqp.Table) string {
+	deaths, ok := headers["x-death"].([]interface{})
+	if !ok || len(deaths) == 0 {
+		return ""
+	}
+	death, ok := deaths[0].(amqp.Table)
+	if !ok {
+		return ""
+	}
+	reason, _ := death["reason"].(string)
+	return reason
+}
@@ -0,0 +1,121 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
+	"github.com/google/uuid"
+	"github.com/streadway/amqp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// schemaVersion is the version of the envelope written by this client. Envelopes with a newer version are rejected
+	schemaVersion = 1
+
+	protobufContentType = "application/x-protobuf"
+	// jsonContentType is the content type of the legacy messages, which are the item or user encoded as JSON
+	jsonContentType = "text/plain"
+)
+
+// newItemPublishing encodes the item in a versioned envelope
+func newItemPublishing(item commonModel.Item) (amqp.Publishing, error) {
+	return newPublishing(itemMessageType, &pb.Envelope{
+		SourceFeed: item.Feed,
+		Payload:    &pb.Envelope_Item{Item: commonModel.ItemToPItem(item)},
+	})
+}
+
+// newUserPublishing encodes the user in a versioned envelope
+func newUserPublishing(user commonModel.User) (amqp.Publishing, error) {
+	return newPublishing(userMessageType, &pb.Envelope{
+		Payload: &pb.Envelope_User{User: commonModel.UserToPUser(user)},
+	})
+}
+
+func newPublishing(messageType string, envelope *pb.Envelope) (amqp.Publishing, error) {
+	producedAt := time.Now().UTC()
+	envelope.MessageId = uuid.NewString()
+	envelope.SchemaVersion = schemaVersion
+	envelope.ProducedAt = timestamppb.New(producedAt)
+
+	body, err := proto.Marshal(envelope)
+	if err != nil {
+		return amqp.Publishing{}, fmt.Errorf("Failed to marshal %s envelope. %w", messageType, err)
+	}
+	return amqp.Publishing{
+		ContentType: protobufContentType,
+		Type:        messageType,
+		MessageId:   envelope.MessageId,
+		Timestamp:   producedAt,
+		Body:        body,
+	}, nil
+}
+
+// decodeMessage decodes either an envelope or a legacy JSON body, based on the content type. Legacy messages without a
+// type are treated as items
+func decodeMessage(delivery amqp.Delivery) (*Message, error) {
+	if delivery.ContentType == protobufContentType {
+		return decodeEnvelope(delivery.Body)
+	}
+
+	switch delivery.Type {
+	case userMessageType:
+		user := commonModel.User{}
+		if err := json.Unmarshal(delivery.Body, &user); err != nil {
+			return nil, err
+		}
+		return &Message{User: &user}, nil
+	case itemMessageType, "":
+		item := commonModel.Item{}
+		if err := json.Unmarshal(delivery.Body, &item); err != nil {
+			return nil, err
+		}
+		return &Message{Item: &item}, nil
+	default:
+		return nil, fmt.Errorf("Unsupported message type %q", delivery.Type)
+	}
+}
+
+func decodeEnvelope(body []byte) (*Message, error) {
+	envelope := &pb.Envelope{}
+	if err := proto.Unmarshal(body, envelope); err != nil {
+		return nil, fmt.Errorf("Unable to unmarshal envelope. %w", err)
+	}
+	if envelope.SchemaVersion > schemaVersion {
+		return nil, fmt.Errorf("Unsupported envelope schema version %d", envelope.SchemaVersion)
+	}
+
+	msg := &Message{ID: envelope.MessageId}
+	if envelope.ProducedAt != nil {
+		msg.ProducedAt = envelope.ProducedAt.AsTime()
+	}
+	switch payload := envelope.Payload.(type) {
+	case *pb.Envelope_Item:
+		item := commonModel.PItemToItem(payload.Item)
+		msg.Item = &item
+	case *pb.Envelope_User:
+		user := commonModel.PUserToUser(payload.User)
+		msg.User = &user
+	default:
+		return nil, fmt.Errorf("Envelope %s has no payload", envelope.MessageId)
+	}
+	return msg, nil
+}
+
+// republishing copies the delivery so that it can be published again with the given headers, keeping its message id
+// and timestamp
+func republishing(delivery amqp.Delivery, headers amqp.Table) amqp.Publishing {
+	return amqp.Publishing{
+		ContentType: delivery.ContentType,
+		Type:        delivery.Type,
+		MessageId:   delivery.MessageId,
+		Timestamp:   delivery.Timestamp,
+		Headers:     headers,
+		Body:        delivery.Body,
+	}
+}
@@ -0,0 +1,102 @@
+package queue
+
+import (
+	"testing"
+
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	item := commonModel.Item{ID: 1, Type: "story", Title: "Title", Feed: "top", Kids: []int{2, 3}}
+	itemPublishing, err := newItemPublishing(item)
+	require.NoError(t, err)
+	user := commonModel.User{ID: "user", Karma: 10}
+	userPublishing, err := newUserPublishing(user)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		publishing   amqp.Publishing
+		expectedType string
+		expectedItem *commonModel.Item
+		expectedUser *commonModel.User
+	}{
+		"Item": {publishing: itemPublishing, expectedType: itemMessageType, expectedItem: &item},
+		"User": {publishing: userPublishing, expectedType: userMessageType, expectedUser: &user},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			publishing := testConfig.publishing
+			assert.Equal(t, protobufContentType, publishing.ContentType)
+			assert.Equal(t, testConfig.expectedType, publishing.Type)
+			assert.NotEmpty(t, publishing.MessageId)
+			assert.False(t, publishing.Timestamp.IsZero())
+
+			msg, err := decodeMessage(amqp.Delivery{ContentType: publishing.ContentType, Type: publishing.Type, Body: publishing.Body})
+			require.NoError(t, err)
+			assert.Equal(t, publishing.MessageId, msg.ID)
+			assert.True(t, publishing.Timestamp.Equal(msg.ProducedAt))
+			assert.Equal(t, testConfig.expectedItem, msg.Item)
+			assert.Equal(t, testConfig.expectedUser, msg.User)
+		})
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	marshal := func(envelope *pb.Envelope) []byte {
+		body, err := proto.Marshal(envelope)
+		require.NoError(t, err)
+		return body
+	}
+
+	tests := map[string]struct {
+		delivery     amqp.Delivery
+		expectedItem *commonModel.Item
+		expectedUser *commonModel.User
+		expectedErr  string
+	}{
+		"Legacy JSON item": {
+			delivery:     amqp.Delivery{ContentType: jsonContentType, Type: itemMessageType, Body: []byte(`{"id":1,"type":"story"}`)},
+			expectedItem: &commonModel.Item{ID: 1, Type: "story"},
+		},
+		"Legacy JSON item without a type": {
+			delivery:     amqp.Delivery{Body: []byte(`{"id":1}`)},
+			expectedItem: &commonModel.Item{ID: 1},
+		},
+		"Legacy JSON user": {
+			delivery:     amqp.Delivery{ContentType: jsonContentType, Type: userMessageType, Body: []byte(`{"id":"user"}`)},
+			expectedUser: &commonModel.User{ID: "user"},
+		},
+		"Legacy JSON with an unsupported type": {
+			delivery:    amqp.Delivery{Type: "poll", Body: []byte(`{}`)},
+			expectedErr: `Unsupported message type "poll"`,
+		},
+		"Envelope from a newer schema version": {
+			delivery: amqp.Delivery{ContentType: protobufContentType, Body: marshal(&pb.Envelope{
+				SchemaVersion: schemaVersion + 1,
+				Payload:       &pb.Envelope_Item{Item: &pb.Item{Id: 1}},
+			})},
+			expectedErr: "Unsupported envelope schema version 2",
+		},
+		"Envelope without a payload": {
+			delivery:    amqp.Delivery{ContentType: protobufContentType, Body: marshal(&pb.Envelope{MessageId: "id", SchemaVersion: schemaVersion})},
+			expectedErr: "Envelope id has no payload",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			msg, err := decodeMessage(testConfig.delivery)
+			if testConfig.expectedErr != "" {
+				assert.EqualError(t, err, testConfig.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedItem, msg.Item)
+			assert.Equal(t, testConfig.expectedUser, msg.User)
+		})
+	}
+}
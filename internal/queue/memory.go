@@ -0,0 +1,434 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// sharedBroker is used by every in-memory client in the process, so a publisher and consumer running in the same
+// process exchange messages through the queues they declare
+var sharedBroker = newMemoryBroker()
+
+// memoryBroker routes messages published to an exchange to the queues bound with a matching topic pattern, in the
+// same way as the RabbitMQ topic exchange
+type memoryBroker struct {
+	mu       sync.Mutex
+	queues   map[string]*memoryQueue
+	bindings map[string][]memoryBinding
+}
+
+type memoryBinding struct {
+	pattern string
+	queue   *memoryQueue
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		queues:   map[string]*memoryQueue{},
+		bindings: map[string][]memoryBinding{},
+	}
+}
+
+// declare returns the named queue, creating it if needed, and binds it to the exchange with each of the patterns
+func (b *memoryBroker) declare(exchange, name string, patterns []string) *memoryQueue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	q, ok := b.queues[name]
+	if !ok {
+		q = newMemoryQueue()
+		b.queues[name] = q
+	}
+
+bind:
+	for _, pattern := range patterns {
+		for _, binding := range b.bindings[exchange] {
+			if binding.queue == q && binding.pattern == pattern {
+				continue bind
+			}
+		}
+		b.bindings[exchange] = append(b.bindings[exchange], memoryBinding{pattern: pattern, queue: q})
+	}
+	return q
+}
+
+// publish routes the message to every queue with a binding that matches the routing key. Like RabbitMQ, a message
+// that matches no binding is dropped
+func (b *memoryBroker) publish(exchange, routingKey string, msg amqp.Publishing) {
+	b.mu.Lock()
+	var queues []*memoryQueue
+	routed := map[*memoryQueue]bool{}
+	for _, binding := range b.bindings[exchange] {
+		if !routed[binding.queue] && topicMatches(binding.pattern, routingKey) {
+			routed[binding.queue] = true
+			queues = append(queues, binding.queue)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, q := range queues {
+		q.push(&memoryDelivery{publishing: msg})
+	}
+}
+
+// topicMatches reports whether the routing key matches the pattern, where `*` matches exactly one word and `#` matches
+// zero or more words
+func topicMatches(pattern, routingKey string) bool {
+	return wordsMatch(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+func wordsMatch(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if wordsMatch(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && wordsMatch(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && wordsMatch(pattern[1:], words[1:])
+	}
+}
+
+// memoryDelivery is a message held by a memoryQueue along with the state RabbitMQ keeps in the message headers
+type memoryDelivery struct {
+	publishing  amqp.Publishing
+	retries     int
+	redelivered bool
+	// reason is why the message was dead-lettered
+	reason string
+	tag    uint64
+	owner  *memoryClient
+}
+
+// delivery converts the message into the delivery RabbitMQ would have sent, so that it is decoded the same way
+func (d *memoryDelivery) delivery() amqp.Delivery {
+	headers := amqp.Table{}
+	if d.retries > 0 {
+		headers[retryCountHeader] = int32(d.retries)
+	}
+	if d.reason != "" {
+		headers["x-death"] = []interface{}{amqp.Table{"reason": d.reason}}
+	}
+	return amqp.Delivery{
+		ContentType: d.publishing.ContentType,
+		Type:        d.publishing.Type,
+		MessageId:   d.publishing.MessageId,
+		Timestamp:   d.publishing.Timestamp,
+		Headers:     headers,
+		Redelivered: d.redelivered,
+		DeliveryTag: d.tag,
+		Body:        d.publishing.Body,
+	}
+}
+
+// memoryQueue holds the messages that are ready to be delivered, delivered but not yet acknowledged, and
+// dead-lettered. changed is closed and replaced whenever a message becomes ready or is acknowledged
+type memoryQueue struct {
+	mu          sync.Mutex
+	ready       []*memoryDelivery
+	unacked     map[uint64]*memoryDelivery
+	deadLetters []*memoryDelivery
+	nextTag     uint64
+	changed     chan struct{}
+}
+
+func newMemoryQueue() *memoryQueue {
+	return &memoryQueue{
+		unacked: map[uint64]*memoryDelivery{},
+		changed: make(chan struct{}),
+	}
+}
+
+// broadcast wakes the consumers waiting on the queue. It must be called with mu held
+func (q *memoryQueue) broadcast() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+func (q *memoryQueue) push(d *memoryDelivery) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.ready = append(q.ready, d)
+	q.broadcast()
+}
+
+// settle removes an unacknowledged message so that it can be acknowledged, requeued or dead-lettered
+func (q *memoryQueue) settle(tag uint64) (*memoryDelivery, error) {
+	d, ok := q.unacked[tag]
+	if !ok {
+		return nil, fmt.Errorf("Unknown delivery tag %d, the message has already been settled", tag)
+	}
+	delete(q.unacked, tag)
+	q.broadcast()
+	return d, nil
+}
+
+func (q *memoryQueue) ack(tag uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	_, err := q.settle(tag)
+	return err
+}
+
+// nack returns the message to the front of the queue when requeue is true, otherwise it is dead-lettered
+func (q *memoryQueue) nack(tag uint64, requeue bool) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	d, err := q.settle(tag)
+	if err != nil {
+		return err
+	}
+	if requeue {
+		d.redelivered = true
+		q.ready = append([]*memoryDelivery{d}, q.ready...)
+		return nil
+	}
+	d.reason = "rejected"
+	q.deadLetters = append(q.deadLetters, d)
+	return nil
+}
+
+// requeueOwned returns every message delivered to the client but not yet acknowledged to the front of the queue, as
+// RabbitMQ does when a connection closes
+func (q *memoryQueue) requeueOwned(owner *memoryClient) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var requeued []*memoryDelivery
+	for tag, d := range q.unacked {
+		if d.owner == owner {
+			delete(q.unacked, tag)
+			d.redelivered = true
+			requeued = append(requeued, d)
+		}
+	}
+	if len(requeued) > 0 {
+		q.ready = append(requeued, q.ready...)
+		q.broadcast()
+	}
+}
+
+type memoryClient struct {
+	logger     *zap.Logger
+	ctx        context.Context
+	broker     *memoryBroker
+	queue      *memoryQueue
+	exchange   string
+	maxRetries int
+	retryDelay time.Duration
+	prefetch   int
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewMemory returns an in-process client with the same acknowledgement, retry and dead-letter behaviour as the
+// RabbitMQ client. Clients in the same process that use the same queue name share the queue
+func NewMemory(logger *zap.Logger, ctx context.Context, amqpConfig *model.RabbitMqConfig) *memoryClient {
+	return newMemoryClient(logger, ctx, sharedBroker, amqpConfig)
+}
+
+func newMemoryClient(logger *zap.Logger, ctx context.Context, broker *memoryBroker, amqpConfig *model.RabbitMqConfig) *memoryClient {
+	topology := newTopology(amqpConfig)
+	return &memoryClient{
+		logger:     logger,
+		ctx:        ctx,
+		broker:     broker,
+		queue:      broker.declare(topology.exchange, topology.queue, topology.bindingKeys),
+		exchange:   topology.exchange,
+		maxRetries: amqpConfig.MaxRetries,
+		retryDelay: topology.retryDelay,
+		prefetch:   amqpConfig.Prefetch,
+		closed:     make(chan struct{}),
+	}
+}
+
+func (c *memoryClient) SendMessage(item commonModel.Item) error {
+	msg, err := newItemPublishing(item)
+	if err != nil {
+		return err
+	}
+	c.broker.publish(c.exchange, itemRoutingKey(item), msg)
+	c.logger.Info("Item successfully pushed to queue")
+	return nil
+}
+
+func (c *memoryClient) SendUser(user commonModel.User) error {
+	msg, err := newUserPublishing(user)
+	if err != nil {
+		return err
+	}
+	c.broker.publish(c.exchange, userRoutingKey, msg)
+	c.logger.Info("User successfully pushed to queue")
+	return nil
+}
+
+// ReceiveMessage pushes the messages on the queue onto msgChan until the client is closed or its context is done
+func (c *memoryClient) ReceiveMessage(msgChan chan *Message) error {
+	for {
+		d := c.next()
+		if d == nil {
+			return nil
+		}
+
+		msg, err := decodeMessage(d.delivery())
+		if err != nil {
+			c.logger.Error("Unable to decode message", zap.String("content_type", d.publishing.ContentType), zap.Error(err))
+			if err := c.queue.nack(d.tag, false); err != nil {
+				c.logger.Error("Unable to reject message", zap.Error(err))
+			}
+			continue
+		}
+		tag := d.tag
+		msg.ack = func() error {
+			return c.queue.ack(tag)
+		}
+		msg.nack = func(requeue bool) error {
+			return c.queue.nack(tag, requeue)
+		}
+		msg.retry = func() error {
+			return c.retry(tag)
+		}
+		select {
+		case msgChan <- msg:
+		case <-c.closed:
+			return nil
+		case <-c.ctx.Done():
+			// The message was not handed out, so it is returned to the queue rather than held until the client closes
+			if err := c.queue.nack(tag, true); err != nil {
+				c.logger.Error("Unable to requeue message", zap.Error(err))
+			}
+			return nil
+		}
+	}
+}
+
+// next waits for a message to be ready and for the client to have fewer unacknowledged messages than the prefetch
+// count, returning nil once the client is closed or its context is done
+func (c *memoryClient) next() *memoryDelivery {
+	q := c.queue
+	for {
+		select {
+		case <-c.closed:
+			return nil
+		case <-c.ctx.Done():
+			return nil
+		default:
+		}
+
+		q.mu.Lock()
+		if len(q.ready) > 0 && (c.prefetch <= 0 || c.unackedLocked() < c.prefetch) {
+			d := q.ready[0]
+			q.ready = q.ready[1:]
+			q.nextTag++
+			d.tag = q.nextTag
+			d.owner = c
+			q.unacked[d.tag] = d
+			q.mu.Unlock()
+			return d
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-c.closed:
+			return nil
+		case <-c.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// unackedLocked counts the messages delivered to the client that are not yet acknowledged. It must be called with the
+// queue's mu held
+func (c *memoryClient) unackedLocked() int {
+	count := 0
+	for _, d := range c.queue.unacked {
+		if d.owner == c {
+			count++
+		}
+	}
+	return count
+}
+
+// retry returns the message to the back of the queue after the retry delay with its retry count incremented, or
+// dead-letters it once it has been retried the maximum number of times
+func (c *memoryClient) retry(tag uint64) error {
+	q := c.queue
+	q.mu.Lock()
+	d, err := q.settle(tag)
+	if err != nil {
+		q.mu.Unlock()
+		return err
+	}
+	if d.retries >= c.maxRetries {
+		c.logger.Warn("Message reached the max retries, dead-lettering it", zap.Int("retries", d.retries))
+		d.reason = "rejected"
+		q.deadLetters = append(q.deadLetters, d)
+		q.mu.Unlock()
+		return nil
+	}
+	q.mu.Unlock()
+
+	retried := &memoryDelivery{publishing: d.publishing, retries: d.retries + 1}
+	time.AfterFunc(c.retryDelay, func() {
+		q.push(retried)
+	})
+	return nil
+}
+
+// ListDeadLetters returns up to limit dead-lettered messages, or all of them when limit is zero
+func (c *memoryClient) ListDeadLetters(limit int) ([]DeadLetter, error) {
+	q := c.queue
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	deadLetters := make([]DeadLetter, 0, len(q.deadLetters))
+	for _, d := range q.deadLetters {
+		if limit > 0 && len(deadLetters) == limit {
+			break
+		}
+		deadLetters = append(deadLetters, newDeadLetter(d.delivery()))
+	}
+	return deadLetters, nil
+}
+
+// ReplayDeadLetters moves up to limit dead-lettered messages, or all of them when limit is zero, back onto the queue
+// with their retry count reset
+func (c *memoryClient) ReplayDeadLetters(limit int) (int, error) {
+	q := c.queue
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if limit <= 0 || limit > len(q.deadLetters) {
+		limit = len(q.deadLetters)
+	}
+	for _, d := range q.deadLetters[:limit] {
+		q.ready = append(q.ready, &memoryDelivery{publishing: d.publishing})
+	}
+	q.deadLetters = q.deadLetters[limit:]
+	if limit > 0 {
+		q.broadcast()
+	}
+	return limit, nil
+}
+
+// CloseConnection stops delivering messages and requeues the messages that have not been acknowledged
+func (c *memoryClient) CloseConnection() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.queue.requeueOwned(c)
+	})
+}
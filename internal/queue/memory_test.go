@@ -0,0 +1,176 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTopicMatches(t *testing.T) {
+	tests := map[string]struct {
+		pattern    string
+		routingKey string
+		expected   bool
+	}{
+		"Exact match":                     {pattern: "item.story.top", routingKey: "item.story.top", expected: true},
+		"Different word":                  {pattern: "item.story.top", routingKey: "item.job.top", expected: false},
+		"Star matches one word":           {pattern: "item.*.top", routingKey: "item.story.top", expected: true},
+		"Star does not match zero words":  {pattern: "item.*.top", routingKey: "item.top", expected: false},
+		"Hash matches everything":         {pattern: "#", routingKey: "item.story.top", expected: true},
+		"Hash matches zero words":         {pattern: "item.job.#", routingKey: "item.job", expected: true},
+		"Hash matches several words":      {pattern: "item.#", routingKey: "item.job.job", expected: true},
+		"Hash in the middle":              {pattern: "item.#.top", routingKey: "item.story.top", expected: true},
+		"Longer routing key than pattern": {pattern: "item.*", routingKey: "item.story.top", expected: false},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, topicMatches(testConfig.pattern, testConfig.routingKey))
+		})
+	}
+}
+
+func newTestMemoryClient(t *testing.T, broker *memoryBroker, amqpConfig *model.RabbitMqConfig) (*memoryClient, chan *Message) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	c := newMemoryClient(zap.NewNop(), ctx, broker, amqpConfig)
+	msgChan := make(chan *Message)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, c.ReceiveMessage(msgChan))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return c, msgChan
+}
+
+func receive(t *testing.T, msgChan chan *Message) *Message {
+	t.Helper()
+	select {
+	case msg := <-msgChan:
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for a message")
+		return nil
+	}
+}
+
+func assertNoMessage(t *testing.T, msgChan chan *Message) {
+	t.Helper()
+	select {
+	case msg := <-msgChan:
+		assert.Failf(t, "Unexpected message", "%+v", msg)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestMemoryClientRouting(t *testing.T) {
+	broker := newMemoryBroker()
+	_, all := newTestMemoryClient(t, broker, &model.RabbitMqConfig{QueueName: "items"})
+	jobsClient, jobs := newTestMemoryClient(t, broker, &model.RabbitMqConfig{QueueName: "jobs", BindingKeys: []string{"item.job.#"}})
+
+	require.NoError(t, jobsClient.SendMessage(commonModel.Item{ID: 1, Type: "story", Feed: "top"}))
+	require.NoError(t, jobsClient.SendMessage(commonModel.Item{ID: 2, Type: "job", Feed: "job"}))
+	require.NoError(t, jobsClient.SendUser(commonModel.User{ID: "user"}))
+
+	assert.Equal(t, 1, receive(t, all).Item.ID)
+	assert.Equal(t, 2, receive(t, all).Item.ID)
+	assert.Equal(t, "user", receive(t, all).User.ID)
+	assert.Equal(t, 2, receive(t, jobs).Item.ID)
+	assertNoMessage(t, jobs)
+}
+
+func TestMemoryClientAcknowledgement(t *testing.T) {
+	t.Run("Acknowledged messages are removed", func(t *testing.T) {
+		c, msgChan := newTestMemoryClient(t, newMemoryBroker(), &model.RabbitMqConfig{QueueName: "items"})
+		require.NoError(t, c.SendMessage(commonModel.Item{ID: 1}))
+
+		msg := receive(t, msgChan)
+		assert.NoError(t, msg.Ack())
+		assert.EqualError(t, msg.Ack(), "Unknown delivery tag 1, the message has already been settled")
+		assertNoMessage(t, msgChan)
+	})
+
+	t.Run("Requeued messages are redelivered", func(t *testing.T) {
+		c, msgChan := newTestMemoryClient(t, newMemoryBroker(), &model.RabbitMqConfig{QueueName: "items"})
+		require.NoError(t, c.SendMessage(commonModel.Item{ID: 1}))
+
+		msg := receive(t, msgChan)
+		assert.NoError(t, msg.Nack(true))
+		redelivered := receive(t, msgChan)
+		assert.Equal(t, msg.ID, redelivered.ID)
+		assert.NoError(t, redelivered.Ack())
+	})
+
+	t.Run("Rejected messages are dead-lettered and can be replayed", func(t *testing.T) {
+		c, msgChan := newTestMemoryClient(t, newMemoryBroker(), &model.RabbitMqConfig{QueueName: "items"})
+		item := commonModel.Item{ID: 1, Type: "story"}
+		require.NoError(t, c.SendMessage(item))
+
+		assert.NoError(t, receive(t, msgChan).Nack(false))
+		assertNoMessage(t, msgChan)
+		deadLetters, err := c.ListDeadLetters(0)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		assert.Equal(t, "rejected", deadLetters[0].Reason)
+		assert.Equal(t, &item, deadLetters[0].Item)
+
+		replayed, err := c.ReplayDeadLetters(0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, replayed)
+		assert.Equal(t, 1, receive(t, msgChan).Item.ID)
+	})
+
+	t.Run("Closing requeues unacknowledged messages", func(t *testing.T) {
+		broker := newMemoryBroker()
+		config := &model.RabbitMqConfig{QueueName: "items"}
+		c, msgChan := newTestMemoryClient(t, broker, config)
+		require.NoError(t, c.SendMessage(commonModel.Item{ID: 1}))
+
+		msg := receive(t, msgChan)
+		c.CloseConnection()
+		assert.Error(t, msg.Ack())
+
+		_, otherChan := newTestMemoryClient(t, broker, config)
+		assert.Equal(t, 1, receive(t, otherChan).Item.ID)
+	})
+}
+
+func TestMemoryClientRetry(t *testing.T) {
+	c, msgChan := newTestMemoryClient(t, newMemoryBroker(), &model.RabbitMqConfig{
+		QueueName:  "items",
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	})
+	require.NoError(t, c.SendMessage(commonModel.Item{ID: 1}))
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, receive(t, msgChan).Retry())
+	}
+	assert.NoError(t, receive(t, msgChan).Retry())
+	assertNoMessage(t, msgChan)
+
+	deadLetters, err := c.ListDeadLetters(0)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, 2, deadLetters[0].Retries)
+}
+
+func TestMemoryClientPrefetch(t *testing.T) {
+	c, msgChan := newTestMemoryClient(t, newMemoryBroker(), &model.RabbitMqConfig{QueueName: "items", Prefetch: 1})
+	require.NoError(t, c.SendMessage(commonModel.Item{ID: 1}))
+	require.NoError(t, c.SendMessage(commonModel.Item{ID: 2}))
+
+	first := receive(t, msgChan)
+	assertNoMessage(t, msgChan)
+	assert.NoError(t, first.Ack())
+	assert.Equal(t, 2, receive(t, msgChan).Item.ID)
+}
@@ -18,7 +18,12 @@ func (m *Mock) SendMessage(item commonModel.Item) error {
 	return nil
 }
 
-func (m *Mock) ReceiveMessage(msgChan chan *commonModel.Item) error {
+func (m *Mock) SendUser(user commonModel.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *Mock) ReceiveMessage(msgChan chan *Message) error {
 	args := m.Called(msgChan)
 
 	err := args.Error(0)
@@ -28,6 +33,36 @@ func (m *Mock) ReceiveMessage(msgChan chan *commonModel.Item) error {
 	return nil
 }
 
+// NewMessage creates a message whose Ack, Nack and Retry calls are recorded against the mock's methods of the same name
+func (m *Mock) NewMessage(item *commonModel.Item, user *commonModel.User) *Message {
+	msg := &Message{Item: item, User: user}
+	msg.ack = func() error {
+		return m.Ack(msg)
+	}
+	msg.nack = func(requeue bool) error {
+		return m.Nack(msg, requeue)
+	}
+	msg.retry = func() error {
+		return m.Retry(msg)
+	}
+	return msg
+}
+
+func (m *Mock) Ack(msg *Message) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+func (m *Mock) Nack(msg *Message, requeue bool) error {
+	args := m.Called(msg, requeue)
+	return args.Error(0)
+}
+
+func (m *Mock) Retry(msg *Message) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
 func (m *Mock) CloseConnection() {
 	// Do nothing as this is a mock
 }
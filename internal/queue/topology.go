@@ -0,0 +1,164 @@
+package queue
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/streadway/amqp"
+)
+
+const (
+	// retryCountHeader holds the number of times a message has been retried
+	retryCountHeader = "x-retry-count"
+	// userRoutingKey routes user profiles
+	userRoutingKey = "user"
+)
+
+// topology names the exchanges and queues that surround the configured queue. Messages are published to the topic
+// exchange, which routes them to every queue bound with a matching pattern. Messages that fail to save wait in the
+// retry queue until its TTL dead-letters them back onto the queue. Messages rejected from the queue are routed by the
+// dead-letter exchange to the dead-letter queue
+type topology struct {
+	exchange           string
+	bindingKeys        []string
+	queue              string
+	retryQueue         string
+	deadLetterExchange string
+	deadLetterQueue    string
+	retryDelay         time.Duration
+}
+
+func newTopology(amqpConfig *model.RabbitMqConfig) topology {
+	exchange := amqpConfig.Exchange
+	if exchange == "" {
+		exchange = "hackernews"
+	}
+	bindingKeys := amqpConfig.BindingKeys
+	if len(bindingKeys) == 0 {
+		bindingKeys = []string{"#"}
+	}
+	return topology{
+		exchange:           exchange,
+		bindingKeys:        bindingKeys,
+		queue:              amqpConfig.QueueName,
+		retryQueue:         amqpConfig.QueueName + ".retry",
+		deadLetterExchange: amqpConfig.QueueName + ".dlx",
+		deadLetterQueue:    amqpConfig.QueueName + ".dlq",
+		retryDelay:         amqpConfig.RetryDelay,
+	}
+}
+
+// declare creates the queues and exchange, returning the queue messages are published to and consumed from
+func (t topology) declare(channel *amqp.Channel) (amqp.Queue, error) {
+	err := channel.ExchangeDeclare(
+		t.deadLetterExchange,
+		amqp.ExchangeDirect,
+		true,  // durable
+		false, // auto-deleted
+		false, // internal
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to declare dead-letter exchange. %w", err)
+	}
+	if _, err := channel.QueueDeclare(t.deadLetterQueue, true, false, false, false, nil); err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to declare dead-letter queue. %w", err)
+	}
+	if err := channel.QueueBind(t.deadLetterQueue, t.queue, t.deadLetterExchange, false, nil); err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to bind dead-letter queue. %w", err)
+	}
+
+	_, err = channel.QueueDeclare(
+		t.retryQueue,
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		t.retryQueueArguments(),
+	)
+	if err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to declare retry queue. %w", err)
+	}
+
+	queue, err := channel.QueueDeclare(
+		t.queue,
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		t.queueArguments(),
+	)
+	if err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to declare queue. %w", err)
+	}
+
+	err = channel.ExchangeDeclare(
+		t.exchange,
+		amqp.ExchangeTopic,
+		true,  // durable
+		false, // auto-deleted
+		false, // internal
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return amqp.Queue{}, fmt.Errorf("Failed to declare exchange. %w", err)
+	}
+	for _, bindingKey := range t.bindingKeys {
+		if err := channel.QueueBind(t.queue, bindingKey, t.exchange, false, nil); err != nil {
+			return amqp.Queue{}, fmt.Errorf("Failed to bind queue to %q. %w", bindingKey, err)
+		}
+	}
+	return queue, nil
+}
+
+// queueArguments dead-letters rejected messages under the queue name, which the dead-letter queue is bound with, as
+// their original routing key would not match the binding
+func (t topology) queueArguments() amqp.Table {
+	return amqp.Table{
+		"x-dead-letter-exchange":    t.deadLetterExchange,
+		"x-dead-letter-routing-key": t.queue,
+	}
+}
+
+// retryQueueArguments expires messages after the retry delay, dead-lettering them back onto the queue through the
+// default exchange
+func (t topology) retryQueueArguments() amqp.Table {
+	return amqp.Table{
+		"x-message-ttl":             int32(t.retryDelay.Milliseconds()),
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": t.queue,
+	}
+}
+
+// itemRoutingKey routes an item by its type and the feed it was published from, e.g. item.story.top
+func itemRoutingKey(item commonModel.Item) string {
+	return strings.Join([]string{"item", routingWord(item.Type), routingWord(item.Feed)}, ".")
+}
+
+// routingWord makes a value safe to use as a word of a routing key
+func routingWord(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return strings.ReplaceAll(value, ".", "_")
+}
+
+// retryCount reads the retry count header, which is zero for messages that have not been retried
+func retryCount(headers amqp.Table) int {
+	switch count := headers[retryCountHeader].(type) {
+	case int16:
+		return int(count)
+	case int32:
+		return int(count)
+	case int64:
+		return int(count)
+	case int:
+		return count
+	}
+	return 0
+}
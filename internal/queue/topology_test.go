@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/internal/model"
+	commonModel "github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTopology(t *testing.T) {
+	tests := map[string]struct {
+		config   *model.RabbitMqConfig
+		expected topology
+	}{
+		"Configured exchange and bindings": {
+			config: &model.RabbitMqConfig{QueueName: "jobs", Exchange: "hn", BindingKeys: []string{"item.job.*"}, RetryDelay: time.Minute},
+			expected: topology{
+				exchange:           "hn",
+				bindingKeys:        []string{"item.job.*"},
+				queue:              "jobs",
+				retryQueue:         "jobs.retry",
+				deadLetterExchange: "jobs.dlx",
+				deadLetterQueue:    "jobs.dlq",
+				retryDelay:         time.Minute,
+			},
+		},
+		"Defaults to every message on the hackernews exchange": {
+			config: &model.RabbitMqConfig{QueueName: "items"},
+			expected: topology{
+				exchange:           "hackernews",
+				bindingKeys:        []string{"#"},
+				queue:              "items",
+				retryQueue:         "items.retry",
+				deadLetterExchange: "items.dlx",
+				deadLetterQueue:    "items.dlq",
+			},
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, newTopology(testConfig.config))
+		})
+	}
+}
+
+func TestQueueArguments(t *testing.T) {
+	topology := newTopology(&model.RabbitMqConfig{QueueName: "items", RetryDelay: 5 * time.Second})
+
+	// Messages published to the topic exchange keep routing keys such as item.story.top, so they must be dead-lettered
+	// under the queue name for the dead-letter queue binding to match
+	assert.Equal(t, amqp.Table{
+		"x-dead-letter-exchange":    "items.dlx",
+		"x-dead-letter-routing-key": "items",
+	}, topology.queueArguments())
+	assert.Equal(t, amqp.Table{
+		"x-message-ttl":             int32(5000),
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": "items",
+	}, topology.retryQueueArguments())
+}
+
+func TestItemRoutingKey(t *testing.T) {
+	tests := map[string]struct {
+		item     commonModel.Item
+		expected string
+	}{
+		"Story from the top feed": {item: commonModel.Item{Type: "story", Feed: "top"}, expected: "item.story.top"},
+		"Job from the job feed":   {item: commonModel.Item{Type: "job", Feed: "job"}, expected: "item.job.job"},
+		"Missing type and feed":   {item: commonModel.Item{}, expected: "item.unknown.unknown"},
+		"Dots are replaced":       {item: commonModel.Item{Type: "a.b", Feed: "c"}, expected: "item.a_b.c"},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, itemRoutingKey(testConfig.item))
+		})
+	}
+}
+
+func TestRetryCount(t *testing.T) {
+	tests := map[string]struct {
+		headers  amqp.Table
+		expected int
+	}{
+		"No headers":      {expected: 0},
+		"Missing header":  {headers: amqp.Table{"other": "value"}, expected: 0},
+		"int32 header":    {headers: amqp.Table{retryCountHeader: int32(2)}, expected: 2},
+		"int64 header":    {headers: amqp.Table{retryCountHeader: int64(3)}, expected: 3},
+		"Unexpected type": {headers: amqp.Table{retryCountHeader: "4"}, expected: 0},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, retryCount(testConfig.headers))
+		})
+	}
+}
+
+func TestDeathReason(t *testing.T) {
+	tests := map[string]struct {
+		headers  amqp.Table
+		expected string
+	}{
+		"Not dead-lettered": {expected: ""},
+		"Rejected": {
+			headers:  amqp.Table{"x-death": []interface{}{amqp.Table{"reason": "rejected", "queue": "items"}}},
+			expected: "rejected",
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testConfig.expected, deathReason(testConfig.headers))
+		})
+	}
+}
@@ -2,46 +2,87 @@ package model
 
 import pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
 
-// Item represents the API response structure from HackerNew for an item as used for data storage
+// Item represents the API response structure from HackerNew for an item as used for data storage. An empty Feed is not
+// stored, so that an item republished without a feed keeps the one it was saved with
 type Item struct {
-	ID        int    `bson:"id" json:"id"`
-	Type      string `bson:"type" json:"type"`
-	Text      string `bson:"text" json:"text"`
-	URL       string `bson:"url" json:"url"`
-	Score     int    `bson:"score" json:"score"`
-	Title     string `bson:"title" json:"title"`
-	Time      int64  `bson:"time" json:"time"`
-	CreatedBy string `bson:"by" json:"by"`
-	Dead      bool   `bson:"dead" json:"dead"`
-	Deleted   bool   `bson:"deleted" json:"deleted"`
+	ID          int    `bson:"id" json:"id"`
+	Type        string `bson:"type" json:"type"`
+	Text        string `bson:"text" json:"text"`
+	URL         string `bson:"url" json:"url"`
+	Score       int    `bson:"score" json:"score"`
+	Title       string `bson:"title" json:"title"`
+	Time        int64  `bson:"time" json:"time"`
+	CreatedBy   string `bson:"by" json:"by"`
+	Dead        bool   `bson:"dead" json:"dead"`
+	Deleted     bool   `bson:"deleted" json:"deleted"`
+	Feed        string `bson:"feed,omitempty" json:"feed"`
+	Kids        []int  `bson:"kids" json:"kids"`
+	Parent      int    `bson:"parent" json:"parent"`
+	Descendants int    `bson:"descendants" json:"descendants"`
+	Poll        int    `bson:"poll" json:"poll"`
+	Parts       []int  `bson:"parts" json:"parts"`
 }
 
 func PItemToItem(item *pb.Item) Item {
 	return Item{
-		ID:        int(item.Id),
-		Type:      item.Type,
-		Text:      item.Text,
-		URL:       item.Url,
-		Score:     int(item.Score),
-		Title:     item.Title,
-		Time:      item.Time,
-		CreatedBy: item.CreatedBy,
-		Dead:      item.Dead,
-		Deleted:   item.Deleted,
+		ID:          int(item.Id),
+		Type:        item.Type,
+		Text:        item.Text,
+		URL:         item.Url,
+		Score:       int(item.Score),
+		Title:       item.Title,
+		Time:        item.Time,
+		CreatedBy:   item.CreatedBy,
+		Dead:        item.Dead,
+		Deleted:     item.Deleted,
+		Feed:        item.Feed,
+		Kids:        toInts(item.Kids),
+		Parent:      int(item.Parent),
+		Descendants: int(item.Descendants),
+		Poll:        int(item.Poll),
+		Parts:       toInts(item.Parts),
 	}
 }
 
 func ItemToPItem(item Item) *pb.Item {
 	return &pb.Item{
-		Id:        int32(item.ID),
-		Type:      item.Type,
-		Text:      item.Text,
-		Url:       item.URL,
-		Score:     int64(item.Score),
-		Title:     item.Title,
-		Time:      item.Time,
-		CreatedBy: item.CreatedBy,
-		Dead:      item.Dead,
-		Deleted:   item.Deleted,
+		Id:          int32(item.ID),
+		Type:        item.Type,
+		Text:        item.Text,
+		Url:         item.URL,
+		Score:       int64(item.Score),
+		Title:       item.Title,
+		Time:        item.Time,
+		CreatedBy:   item.CreatedBy,
+		Dead:        item.Dead,
+		Deleted:     item.Deleted,
+		Feed:        item.Feed,
+		Kids:        toInt32s(item.Kids),
+		Parent:      int32(item.Parent),
+		Descendants: int32(item.Descendants),
+		Poll:        int32(item.Poll),
+		Parts:       toInt32s(item.Parts),
 	}
 }
+
+func toInts(ids []int32) []int {
+	if ids == nil {
+		return nil
+	}
+	converted := make([]int, len(ids))
+	for i, id := range ids {
+		converted[i] = int(id)
+	}
+	return converted
+}
+
+func toInt32s(ids []int) []int32 {
+	if ids == nil {
+		return nil
+	}
+	converted := make([]int32, len(ids))
+	for i, id := range ids {
+		converted[i] = int32(id)
+	}
+	return converted
+}
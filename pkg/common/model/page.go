@@ -0,0 +1,129 @@
+package model
+
+import pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
+
+// SortOrder is the order a list of items is returned in
+type SortOrder string
+
+const (
+	// SortNewest lists the newest items first, and is used when no sort order is given
+	SortNewest SortOrder = "newest"
+	// SortOldest lists the oldest items first
+	SortOldest SortOrder = "oldest"
+	// SortTopScore lists the highest scoring items first, newest first between items with the same score
+	SortTopScore SortOrder = "score"
+)
+
+var (
+	sortOrders = map[pb.SortOrder]SortOrder{
+		pb.SortOrder_NEWEST:    SortNewest,
+		pb.SortOrder_OLDEST:    SortOldest,
+		pb.SortOrder_TOP_SCORE: SortTopScore,
+	}
+	pSortOrders = map[SortOrder]pb.SortOrder{
+		SortNewest:   pb.SortOrder_NEWEST,
+		SortOldest:   pb.SortOrder_OLDEST,
+		SortTopScore: pb.SortOrder_TOP_SCORE,
+	}
+)
+
+// PageRequest selects a page of the items matching Filter, in Sort order. A zero Limit uses the default page size, an
+// empty Sort lists the newest items first, and an empty Cursor selects the first page
+type PageRequest struct {
+	Limit  int        `json:"limit"`
+	Cursor string     `json:"cursor"`
+	Filter ItemFilter `json:"filter"`
+	Sort   SortOrder  `json:"sort"`
+}
+
+// ItemFilter narrows a list of items, with zero fields matching every item. CreatedAfter and CreatedBefore are unix
+// seconds, and Domain matches the host of the item URL along with its subdomains
+type ItemFilter struct {
+	Type           string `json:"type,omitempty"`
+	Author         string `json:"author,omitempty"`
+	MinScore       *int   `json:"min_score,omitempty"`
+	MaxScore       *int   `json:"max_score,omitempty"`
+	CreatedAfter   int64  `json:"created_after,omitempty"`
+	CreatedBefore  int64  `json:"created_before,omitempty"`
+	Domain         string `json:"domain,omitempty"`
+	ExcludeDead    bool   `json:"exclude_dead,omitempty"`
+	ExcludeDeleted bool   `json:"exclude_deleted,omitempty"`
+}
+
+// ItemPage is a page of items. NextCursor selects the following page, and is empty on the last page
+type ItemPage struct {
+	Items      []*Item `json:"items"`
+	NextCursor string  `json:"next_cursor"`
+}
+
+// PListRequestToPageRequest converts a list request, keeping sort orders it does not know so that they can be rejected
+func PListRequestToPageRequest(request *pb.ListRequest) PageRequest {
+	sort, ok := sortOrders[request.Sort]
+	if !ok {
+		sort = SortOrder(request.Sort.String())
+	}
+	return PageRequest{
+		Limit:  int(request.PageSize),
+		Cursor: request.Cursor,
+		Filter: PItemFilterToItemFilter(request.Filter),
+		Sort:   sort,
+	}
+}
+
+// PageRequestToPListRequest converts a page request, listing the newest items first when the sort order is not known
+func PageRequestToPListRequest(page PageRequest) *pb.ListRequest {
+	return &pb.ListRequest{
+		PageSize: int32(page.Limit),
+		Cursor:   page.Cursor,
+		Filter:   ItemFilterToPItemFilter(page.Filter),
+		Sort:     pSortOrders[page.Sort],
+	}
+}
+
+// PItemFilterToItemFilter converts a filter, which matches every item when it is not set
+func PItemFilterToItemFilter(filter *pb.ItemFilter) ItemFilter {
+	if filter == nil {
+		return ItemFilter{}
+	}
+	return ItemFilter{
+		Type:           filter.Type,
+		Author:         filter.Author,
+		MinScore:       toIntPtr(filter.MinScore),
+		MaxScore:       toIntPtr(filter.MaxScore),
+		CreatedAfter:   filter.CreatedAfter,
+		CreatedBefore:  filter.CreatedBefore,
+		Domain:         filter.Domain,
+		ExcludeDead:    filter.ExcludeDead,
+		ExcludeDeleted: filter.ExcludeDeleted,
+	}
+}
+
+func ItemFilterToPItemFilter(filter ItemFilter) *pb.ItemFilter {
+	return &pb.ItemFilter{
+		Type:           filter.Type,
+		Author:         filter.Author,
+		MinScore:       toInt64Ptr(filter.MinScore),
+		MaxScore:       toInt64Ptr(filter.MaxScore),
+		CreatedAfter:   filter.CreatedAfter,
+		CreatedBefore:  filter.CreatedBefore,
+		Domain:         filter.Domain,
+		ExcludeDead:    filter.ExcludeDead,
+		ExcludeDeleted: filter.ExcludeDeleted,
+	}
+}
+
+func toIntPtr(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func toInt64Ptr(value *int) *int64 {
+	if value == nil {
+		return nil
+	}
+	converted := int64(*value)
+	return &converted
+}
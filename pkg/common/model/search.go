@@ -0,0 +1,59 @@
+package model
+
+import pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
+
+// SearchRequest selects a page of the items matching Filter whose title or text match Query, most relevant first. A
+// zero Limit uses the default page size, and an empty Cursor selects the first page
+type SearchRequest struct {
+	Query  string     `json:"query"`
+	Limit  int        `json:"limit"`
+	Cursor string     `json:"cursor"`
+	Filter ItemFilter `json:"filter"`
+}
+
+// SearchResult is an item found by a search, along with how relevant it is to the query
+type SearchResult struct {
+	Item      *Item   `json:"item"`
+	Relevance float64 `json:"relevance"`
+}
+
+// SearchPage is a page of search results. NextCursor selects the following page, and is empty on the last page
+type SearchPage struct {
+	Results    []*SearchResult `json:"results"`
+	NextCursor string          `json:"next_cursor"`
+}
+
+func PSearchRequestToSearchRequest(request *pb.SearchRequest) SearchRequest {
+	return SearchRequest{
+		Query:  request.Query,
+		Limit:  int(request.PageSize),
+		Cursor: request.Cursor,
+		Filter: PItemFilterToItemFilter(request.Filter),
+	}
+}
+
+func SearchRequestToPSearchRequest(request SearchRequest) *pb.SearchRequest {
+	return &pb.SearchRequest{
+		Query:    request.Query,
+		PageSize: int32(request.Limit),
+		Cursor:   request.Cursor,
+		Filter:   ItemFilterToPItemFilter(request.Filter),
+	}
+}
+
+func PSearchResponseToSearchPage(response *pb.SearchResponse) *SearchPage {
+	results := make([]*SearchResult, len(response.Results))
+	for i, result := range response.Results {
+		item := PItemToItem(result.Item)
+		results[i] = &SearchResult{Item: &item, Relevance: result.Relevance}
+	}
+	return &SearchPage{Results: results, NextCursor: response.NextCursor}
+}
+
+func SearchPageToPSearchResponse(page *SearchPage) *pb.SearchResponse {
+	results := make([]*pb.SearchResult, len(page.Results))
+	for i, result := range page.Results {
+		results[i] = &pb.SearchResult{Item: ItemToPItem(*result.Item), Relevance: result.Relevance}
+	}
+	return &pb.SearchResponse{Results: results, NextCursor: page.NextCursor}
+}
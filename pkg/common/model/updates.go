@@ -0,0 +1,7 @@
+package model
+
+// Updates represents the API response structure from HackerNews listing the items and profiles that recently changed
+type Updates struct {
+	Items    []int    `json:"items"`
+	Profiles []string `json:"profiles"`
+}
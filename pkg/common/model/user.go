@@ -0,0 +1,32 @@
+package model
+
+import pb "github.com/emmaLP/gs-software-onboarding/pkg/grpc/proto"
+
+// User represents the API response structure from HackerNews for a user profile as used for data storage
+type User struct {
+	ID        string `bson:"id" json:"id"`
+	Created   int64  `bson:"created" json:"created"`
+	Karma     int    `bson:"karma" json:"karma"`
+	About     string `bson:"about" json:"about"`
+	Submitted []int  `bson:"submitted" json:"submitted"`
+}
+
+func PUserToUser(user *pb.User) User {
+	return User{
+		ID:        user.Id,
+		Created:   user.Created,
+		Karma:     int(user.Karma),
+		About:     user.About,
+		Submitted: toInts(user.Submitted),
+	}
+}
+
+func UserToPUser(user User) *pb.User {
+	return &pb.User{
+		Id:        user.ID,
+		Created:   user.Created,
+		Karma:     int32(user.Karma),
+		About:     user.About,
+		Submitted: toInt32s(user.Submitted),
+	}
+}
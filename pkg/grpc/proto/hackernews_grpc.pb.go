@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -23,10 +22,15 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type APIClient interface {
-	ListAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListAllClient, error)
-	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListJobsClient, error)
-	ListStories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListStoriesClient, error)
+	ListAll(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListStories(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	SaveItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*ItemResponse, error)
+	SaveItems(ctx context.Context, opts ...grpc.CallOption) (API_SaveItemsClient, error)
+	GetItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Item, error)
+	GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error)
+	SaveUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserResponse, error)
 }
 
 type aPIClient struct {
@@ -37,105 +41,106 @@ func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
 	return &aPIClient{cc}
 }
 
-func (c *aPIClient) ListAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], "/hackernews.API/ListAll", opts...)
+func (c *aPIClient) ListAll(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/ListAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIListAllClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type API_ListAllClient interface {
-	Recv() (*Item, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type aPIListAllClient struct {
-	grpc.ClientStream
+func (c *aPIClient) ListJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIListAllClient) Recv() (*Item, error) {
-	m := new(Item)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *aPIClient) ListStories(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/ListStories", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *aPIClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListJobsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[1], "/hackernews.API/ListJobs", opts...)
+func (c *aPIClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/Search", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIListJobsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) SaveItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*ItemResponse, error) {
+	out := new(ItemResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/SaveItem", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) SaveItems(ctx context.Context, opts ...grpc.CallOption) (API_SaveItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], "/hackernews.API/SaveItems", opts...)
+	if err != nil {
 		return nil, err
 	}
+	x := &aPISaveItemsClient{stream}
 	return x, nil
 }
 
-type API_ListJobsClient interface {
-	Recv() (*Item, error)
+type API_SaveItemsClient interface {
+	Send(*Item) error
+	CloseAndRecv() (*SaveItemsResponse, error)
 	grpc.ClientStream
 }
 
-type aPIListJobsClient struct {
+type aPISaveItemsClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIListJobsClient) Recv() (*Item, error) {
-	m := new(Item)
+func (x *aPISaveItemsClient) Send(m *Item) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPISaveItemsClient) CloseAndRecv() (*SaveItemsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SaveItemsResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) ListStories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListStoriesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[2], "/hackernews.API/ListStories", opts...)
+func (c *aPIClient) GetItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	out := new(Item)
+	err := c.cc.Invoke(ctx, "/hackernews.API/GetItem", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIListStoriesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type API_ListStoriesClient interface {
-	Recv() (*Item, error)
-	grpc.ClientStream
-}
-
-type aPIListStoriesClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *aPIListStoriesClient) Recv() (*Item, error) {
-	m := new(Item)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *aPIClient) GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/hackernews.API/GetUser", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *aPIClient) SaveItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*ItemResponse, error) {
-	out := new(ItemResponse)
-	err := c.cc.Invoke(ctx, "/hackernews.API/SaveItem", in, out, opts...)
+func (c *aPIClient) SaveUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/hackernews.API/SaveUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -146,10 +151,15 @@ func (c *aPIClient) SaveItem(ctx context.Context, in *Item, opts ...grpc.CallOpt
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
 type APIServer interface {
-	ListAll(*emptypb.Empty, API_ListAllServer) error
-	ListJobs(*emptypb.Empty, API_ListJobsServer) error
-	ListStories(*emptypb.Empty, API_ListStoriesServer) error
+	ListAll(context.Context, *ListRequest) (*ListResponse, error)
+	ListJobs(context.Context, *ListRequest) (*ListResponse, error)
+	ListStories(context.Context, *ListRequest) (*ListResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	SaveItem(context.Context, *Item) (*ItemResponse, error)
+	SaveItems(API_SaveItemsServer) error
+	GetItem(context.Context, *ItemRequest) (*Item, error)
+	GetUser(context.Context, *UserRequest) (*User, error)
+	SaveUser(context.Context, *User) (*UserResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -157,18 +167,33 @@ type APIServer interface {
 type UnimplementedAPIServer struct {
 }
 
-func (UnimplementedAPIServer) ListAll(*emptypb.Empty, API_ListAllServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListAll not implemented")
+func (UnimplementedAPIServer) ListAll(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAll not implemented")
+}
+func (UnimplementedAPIServer) ListJobs(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
 }
-func (UnimplementedAPIServer) ListJobs(*emptypb.Empty, API_ListJobsServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+func (UnimplementedAPIServer) ListStories(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStories not implemented")
 }
-func (UnimplementedAPIServer) ListStories(*emptypb.Empty, API_ListStoriesServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListStories not implemented")
+func (UnimplementedAPIServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
 func (UnimplementedAPIServer) SaveItem(context.Context, *Item) (*ItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveItem not implemented")
 }
+func (UnimplementedAPIServer) SaveItems(API_SaveItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SaveItems not implemented")
+}
+func (UnimplementedAPIServer) GetItem(context.Context, *ItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedAPIServer) GetUser(context.Context, *UserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAPIServer) SaveUser(context.Context, *User) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveUser not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -182,83 +207,172 @@ func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
 	s.RegisterService(&API_ServiceDesc, srv)
 }
 
-func _API_ListAll_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_ListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/ListAll",
 	}
-	return srv.(APIServer).ListAll(m, &aPIListAllServer{stream})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListAll(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_ListAllServer interface {
-	Send(*Item) error
-	grpc.ServerStream
+func _API_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListJobs(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIListAllServer struct {
-	grpc.ServerStream
+func _API_ListStories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListStories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/ListStories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListStories(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIListAllServer) Send(m *Item) error {
-	return x.ServerStream.SendMsg(m)
+func _API_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_SaveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Item)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SaveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/SaveItem",
 	}
-	return srv.(APIServer).ListJobs(m, &aPIListJobsServer{stream})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SaveItem(ctx, req.(*Item))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_ListJobsServer interface {
-	Send(*Item) error
+func _API_SaveItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).SaveItems(&aPISaveItemsServer{stream})
+}
+
+type API_SaveItemsServer interface {
+	SendAndClose(*SaveItemsResponse) error
+	Recv() (*Item, error)
 	grpc.ServerStream
 }
 
-type aPIListJobsServer struct {
+type aPISaveItemsServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIListJobsServer) Send(m *Item) error {
+func (x *aPISaveItemsServer) SendAndClose(m *SaveItemsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_ListStories_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPISaveItemsServer) Recv() (*Item, error) {
+	m := new(Item)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListStories(m, &aPIListStoriesServer{stream})
-}
-
-type API_ListStoriesServer interface {
-	Send(*Item) error
-	grpc.ServerStream
+	return m, nil
 }
 
-type aPIListStoriesServer struct {
-	grpc.ServerStream
+func _API_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/GetItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetItem(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIListStoriesServer) Send(m *Item) error {
-	return x.ServerStream.SendMsg(m)
+func _API_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hackernews.API/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_SaveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Item)
+func _API_SaveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).SaveItem(ctx, in)
+		return srv.(APIServer).SaveUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hackernews.API/SaveItem",
+		FullMethod: "/hackernews.API/SaveUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SaveItem(ctx, req.(*Item))
+		return srv.(APIServer).SaveUser(ctx, req.(*User))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -270,26 +384,44 @@ var API_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "hackernews.API",
 	HandlerType: (*APIServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAll",
+			Handler:    _API_ListAll_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _API_ListJobs_Handler,
+		},
+		{
+			MethodName: "ListStories",
+			Handler:    _API_ListStories_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _API_Search_Handler,
+		},
 		{
 			MethodName: "SaveItem",
 			Handler:    _API_SaveItem_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "ListAll",
-			Handler:       _API_ListAll_Handler,
-			ServerStreams: true,
+			MethodName: "GetItem",
+			Handler:    _API_GetItem_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _API_GetUser_Handler,
 		},
 		{
-			StreamName:    "ListJobs",
-			Handler:       _API_ListJobs_Handler,
-			ServerStreams: true,
+			MethodName: "SaveUser",
+			Handler:    _API_SaveUser_Handler,
 		},
+	},
+	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "ListStories",
-			Handler:       _API_ListStories_Handler,
-			ServerStreams: true,
+			StreamName:    "SaveItems",
+			Handler:       _API_SaveItems_Handler,
+			ClientStreams: true,
 		},
 	},
 	Metadata: "pkg/grpc/proto/hackernews.proto",
@@ -11,7 +11,6 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // MockAPIClient is a mock of APIClient interface.
@@ -37,15 +36,55 @@ func (m *MockAPIClient) EXPECT() *MockAPIClientMockRecorder {
 	return m.recorder
 }
 
+// GetItem mocks base method.
+func (m *MockAPIClient) GetItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetItem", varargs...)
+	ret0, _ := ret[0].(*Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockAPIClientMockRecorder) GetItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockAPIClient)(nil).GetItem), varargs...)
+}
+
+// GetUser mocks base method.
+func (m *MockAPIClient) GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockAPIClientMockRecorder) GetUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAPIClient)(nil).GetUser), varargs...)
+}
+
 // ListAll mocks base method.
-func (m *MockAPIClient) ListAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListAllClient, error) {
+func (m *MockAPIClient) ListAll(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListAll", varargs...)
-	ret0, _ := ret[0].(API_ListAllClient)
+	ret0, _ := ret[0].(*ListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -58,14 +97,14 @@ func (mr *MockAPIClientMockRecorder) ListAll(ctx, in interface{}, opts ...interf
 }
 
 // ListJobs mocks base method.
-func (m *MockAPIClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListJobsClient, error) {
+func (m *MockAPIClient) ListJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListJobs", varargs...)
-	ret0, _ := ret[0].(API_ListJobsClient)
+	ret0, _ := ret[0].(*ListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -78,14 +117,14 @@ func (mr *MockAPIClientMockRecorder) ListJobs(ctx, in interface{}, opts ...inter
 }
 
 // ListStories mocks base method.
-func (m *MockAPIClient) ListStories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (API_ListStoriesClient, error) {
+func (m *MockAPIClient) ListStories(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListStories", varargs...)
-	ret0, _ := ret[0].(API_ListStoriesClient)
+	ret0, _ := ret[0].(*ListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -117,154 +156,106 @@ func (mr *MockAPIClientMockRecorder) SaveItem(ctx, in interface{}, opts ...inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItem", reflect.TypeOf((*MockAPIClient)(nil).SaveItem), varargs...)
 }
 
-// MockAPI_ListAllClient is a mock of API_ListAllClient interface.
-type MockAPI_ListAllClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockAPI_ListAllClientMockRecorder
-}
-
-// MockAPI_ListAllClientMockRecorder is the mock recorder for MockAPI_ListAllClient.
-type MockAPI_ListAllClientMockRecorder struct {
-	mock *MockAPI_ListAllClient
-}
-
-// NewMockAPI_ListAllClient creates a new mock instance.
-func NewMockAPI_ListAllClient(ctrl *gomock.Controller) *MockAPI_ListAllClient {
-	mock := &MockAPI_ListAllClient{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListAllClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListAllClient) EXPECT() *MockAPI_ListAllClientMockRecorder {
-	return m.recorder
-}
-
-// CloseSend mocks base method.
-func (m *MockAPI_ListAllClient) CloseSend() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseSend")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CloseSend indicates an expected call of CloseSend.
-func (mr *MockAPI_ListAllClientMockRecorder) CloseSend() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAPI_ListAllClient)(nil).CloseSend))
-}
-
-// Context mocks base method.
-func (m *MockAPI_ListAllClient) Context() context.Context {
+// SaveItems mocks base method.
+func (m *MockAPIClient) SaveItems(ctx context.Context, opts ...grpc.CallOption) (API_SaveItemsClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveItems", varargs...)
+	ret0, _ := ret[0].(API_SaveItemsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Context indicates an expected call of Context.
-func (mr *MockAPI_ListAllClientMockRecorder) Context() *gomock.Call {
+// SaveItems indicates an expected call of SaveItems.
+func (mr *MockAPIClientMockRecorder) SaveItems(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListAllClient)(nil).Context))
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItems", reflect.TypeOf((*MockAPIClient)(nil).SaveItems), varargs...)
 }
 
-// Header mocks base method.
-func (m *MockAPI_ListAllClient) Header() (metadata.MD, error) {
+// SaveUser mocks base method.
+func (m *MockAPIClient) SaveUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Header")
-	ret0, _ := ret[0].(metadata.MD)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveUser", varargs...)
+	ret0, _ := ret[0].(*UserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Header indicates an expected call of Header.
-func (mr *MockAPI_ListAllClientMockRecorder) Header() *gomock.Call {
+// SaveUser indicates an expected call of SaveUser.
+func (mr *MockAPIClientMockRecorder) SaveUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAPI_ListAllClient)(nil).Header))
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUser", reflect.TypeOf((*MockAPIClient)(nil).SaveUser), varargs...)
 }
 
-// Recv mocks base method.
-func (m *MockAPI_ListAllClient) Recv() (*Item, error) {
+// Search mocks base method.
+func (m *MockAPIClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*Item)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*SearchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Recv indicates an expected call of Recv.
-func (mr *MockAPI_ListAllClientMockRecorder) Recv() *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockAPIClientMockRecorder) Search(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAPI_ListAllClient)(nil).Recv))
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAPIClient)(nil).Search), varargs...)
 }
 
-// RecvMsg mocks base method.
-func (m_2 *MockAPI_ListAllClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockAPI_SaveItemsClient is a mock of API_SaveItemsClient interface.
+type MockAPI_SaveItemsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPI_SaveItemsClientMockRecorder
 }
 
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListAllClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListAllClient)(nil).RecvMsg), m)
+// MockAPI_SaveItemsClientMockRecorder is the mock recorder for MockAPI_SaveItemsClient.
+type MockAPI_SaveItemsClientMockRecorder struct {
+	mock *MockAPI_SaveItemsClient
 }
 
-// SendMsg mocks base method.
-func (m_2 *MockAPI_ListAllClient) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockAPI_SaveItemsClient creates a new mock instance.
+func NewMockAPI_SaveItemsClient(ctrl *gomock.Controller) *MockAPI_SaveItemsClient {
+	mock := &MockAPI_SaveItemsClient{ctrl: ctrl}
+	mock.recorder = &MockAPI_SaveItemsClientMockRecorder{mock}
+	return mock
 }
 
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListAllClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListAllClient)(nil).SendMsg), m)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI_SaveItemsClient) EXPECT() *MockAPI_SaveItemsClientMockRecorder {
+	return m.recorder
 }
 
-// Trailer mocks base method.
-func (m *MockAPI_ListAllClient) Trailer() metadata.MD {
+// CloseAndRecv mocks base method.
+func (m *MockAPI_SaveItemsClient) CloseAndRecv() (*SaveItemsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trailer")
-	ret0, _ := ret[0].(metadata.MD)
-	return ret0
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*SaveItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Trailer indicates an expected call of Trailer.
-func (mr *MockAPI_ListAllClientMockRecorder) Trailer() *gomock.Call {
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockAPI_SaveItemsClientMockRecorder) CloseAndRecv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAPI_ListAllClient)(nil).Trailer))
-}
-
-// MockAPI_ListJobsClient is a mock of API_ListJobsClient interface.
-type MockAPI_ListJobsClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockAPI_ListJobsClientMockRecorder
-}
-
-// MockAPI_ListJobsClientMockRecorder is the mock recorder for MockAPI_ListJobsClient.
-type MockAPI_ListJobsClientMockRecorder struct {
-	mock *MockAPI_ListJobsClient
-}
-
-// NewMockAPI_ListJobsClient creates a new mock instance.
-func NewMockAPI_ListJobsClient(ctrl *gomock.Controller) *MockAPI_ListJobsClient {
-	mock := &MockAPI_ListJobsClient{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListJobsClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListJobsClient) EXPECT() *MockAPI_ListJobsClientMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).CloseAndRecv))
 }
 
 // CloseSend mocks base method.
-func (m *MockAPI_ListJobsClient) CloseSend() error {
+func (m *MockAPI_SaveItemsClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -272,13 +263,13 @@ func (m *MockAPI_ListJobsClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend.
-func (mr *MockAPI_ListJobsClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).CloseSend))
 }
 
 // Context mocks base method.
-func (m *MockAPI_ListJobsClient) Context() context.Context {
+func (m *MockAPI_SaveItemsClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -286,13 +277,13 @@ func (m *MockAPI_ListJobsClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockAPI_ListJobsClientMockRecorder) Context() *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).Context))
 }
 
 // Header mocks base method.
-func (m *MockAPI_ListJobsClient) Header() (metadata.MD, error) {
+func (m *MockAPI_SaveItemsClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -301,28 +292,13 @@ func (m *MockAPI_ListJobsClient) Header() (metadata.MD, error) {
 }
 
 // Header indicates an expected call of Header.
-func (mr *MockAPI_ListJobsClientMockRecorder) Header() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).Header))
-}
-
-// Recv mocks base method.
-func (m *MockAPI_ListJobsClient) Recv() (*Item, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*Item)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Recv indicates an expected call of Recv.
-func (mr *MockAPI_ListJobsClientMockRecorder) Recv() *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).Header))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockAPI_ListJobsClient) RecvMsg(m interface{}) error {
+func (m_2 *MockAPI_SaveItemsClient) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -330,136 +306,27 @@ func (m_2 *MockAPI_ListJobsClient) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListJobsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).RecvMsg), m)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockAPI_ListJobsClient) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListJobsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).SendMsg), m)
-}
-
-// Trailer mocks base method.
-func (m *MockAPI_ListJobsClient) Trailer() metadata.MD {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trailer")
-	ret0, _ := ret[0].(metadata.MD)
-	return ret0
-}
-
-// Trailer indicates an expected call of Trailer.
-func (mr *MockAPI_ListJobsClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAPI_ListJobsClient)(nil).Trailer))
-}
-
-// MockAPI_ListStoriesClient is a mock of API_ListStoriesClient interface.
-type MockAPI_ListStoriesClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockAPI_ListStoriesClientMockRecorder
-}
-
-// MockAPI_ListStoriesClientMockRecorder is the mock recorder for MockAPI_ListStoriesClient.
-type MockAPI_ListStoriesClientMockRecorder struct {
-	mock *MockAPI_ListStoriesClient
-}
-
-// NewMockAPI_ListStoriesClient creates a new mock instance.
-func NewMockAPI_ListStoriesClient(ctrl *gomock.Controller) *MockAPI_ListStoriesClient {
-	mock := &MockAPI_ListStoriesClient{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListStoriesClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListStoriesClient) EXPECT() *MockAPI_ListStoriesClientMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).RecvMsg), m)
 }
 
-// CloseSend mocks base method.
-func (m *MockAPI_ListStoriesClient) CloseSend() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseSend")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CloseSend indicates an expected call of CloseSend.
-func (mr *MockAPI_ListStoriesClientMockRecorder) CloseSend() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).CloseSend))
-}
-
-// Context mocks base method.
-func (m *MockAPI_ListStoriesClient) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockAPI_ListStoriesClientMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).Context))
-}
-
-// Header mocks base method.
-func (m *MockAPI_ListStoriesClient) Header() (metadata.MD, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Header")
-	ret0, _ := ret[0].(metadata.MD)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Header indicates an expected call of Header.
-func (mr *MockAPI_ListStoriesClientMockRecorder) Header() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).Header))
-}
-
-// Recv mocks base method.
-func (m *MockAPI_ListStoriesClient) Recv() (*Item, error) {
+// Send mocks base method.
+func (m *MockAPI_SaveItemsClient) Send(arg0 *Item) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*Item)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Recv indicates an expected call of Recv.
-func (mr *MockAPI_ListStoriesClientMockRecorder) Recv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).Recv))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockAPI_ListStoriesClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListStoriesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockAPI_SaveItemsClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).Send), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockAPI_ListStoriesClient) SendMsg(m interface{}) error {
+func (m_2 *MockAPI_SaveItemsClient) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -467,13 +334,13 @@ func (m_2 *MockAPI_ListStoriesClient) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListStoriesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).SendMsg), m)
 }
 
 // Trailer mocks base method.
-func (m *MockAPI_ListStoriesClient) Trailer() metadata.MD {
+func (m *MockAPI_SaveItemsClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -481,9 +348,9 @@ func (m *MockAPI_ListStoriesClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer.
-func (mr *MockAPI_ListStoriesClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockAPI_SaveItemsClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAPI_ListStoriesClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAPI_SaveItemsClient)(nil).Trailer))
 }
 
 // MockAPIServer is a mock of APIServer interface.
@@ -509,12 +376,43 @@ func (m *MockAPIServer) EXPECT() *MockAPIServerMockRecorder {
 	return m.recorder
 }
 
+// GetItem mocks base method.
+func (m *MockAPIServer) GetItem(arg0 context.Context, arg1 *ItemRequest) (*Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", arg0, arg1)
+	ret0, _ := ret[0].(*Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockAPIServerMockRecorder) GetItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockAPIServer)(nil).GetItem), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockAPIServer) GetUser(arg0 context.Context, arg1 *UserRequest) (*User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockAPIServerMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAPIServer)(nil).GetUser), arg0, arg1)
+}
+
 // ListAll mocks base method.
-func (m *MockAPIServer) ListAll(arg0 *emptypb.Empty, arg1 API_ListAllServer) error {
+func (m *MockAPIServer) ListAll(arg0 context.Context, arg1 *ListRequest) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ListAll indicates an expected call of ListAll.
@@ -524,11 +422,12 @@ func (mr *MockAPIServerMockRecorder) ListAll(arg0, arg1 interface{}) *gomock.Cal
 }
 
 // ListJobs mocks base method.
-func (m *MockAPIServer) ListJobs(arg0 *emptypb.Empty, arg1 API_ListJobsServer) error {
+func (m *MockAPIServer) ListJobs(arg0 context.Context, arg1 *ListRequest) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListJobs", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ListJobs indicates an expected call of ListJobs.
@@ -538,11 +437,12 @@ func (mr *MockAPIServerMockRecorder) ListJobs(arg0, arg1 interface{}) *gomock.Ca
 }
 
 // ListStories mocks base method.
-func (m *MockAPIServer) ListStories(arg0 *emptypb.Empty, arg1 API_ListStoriesServer) error {
+func (m *MockAPIServer) ListStories(arg0 context.Context, arg1 *ListRequest) (*ListResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStories", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ListStories indicates an expected call of ListStories.
@@ -566,6 +466,50 @@ func (mr *MockAPIServerMockRecorder) SaveItem(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItem", reflect.TypeOf((*MockAPIServer)(nil).SaveItem), arg0, arg1)
 }
 
+// SaveItems mocks base method.
+func (m *MockAPIServer) SaveItems(arg0 API_SaveItemsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveItems", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveItems indicates an expected call of SaveItems.
+func (mr *MockAPIServerMockRecorder) SaveItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItems", reflect.TypeOf((*MockAPIServer)(nil).SaveItems), arg0)
+}
+
+// SaveUser mocks base method.
+func (m *MockAPIServer) SaveUser(arg0 context.Context, arg1 *User) (*UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUser", arg0, arg1)
+	ret0, _ := ret[0].(*UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveUser indicates an expected call of SaveUser.
+func (mr *MockAPIServerMockRecorder) SaveUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUser", reflect.TypeOf((*MockAPIServer)(nil).SaveUser), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockAPIServer) Search(arg0 context.Context, arg1 *SearchRequest) (*SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAPIServerMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAPIServer)(nil).Search), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAPIServer mocks base method.
 func (m *MockAPIServer) mustEmbedUnimplementedAPIServer() {
 	m.ctrl.T.Helper()
@@ -613,31 +557,31 @@ func (mr *MockUnsafeAPIServerMockRecorder) mustEmbedUnimplementedAPIServer() *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAPIServer", reflect.TypeOf((*MockUnsafeAPIServer)(nil).mustEmbedUnimplementedAPIServer))
 }
 
-// MockAPI_ListAllServer is a mock of API_ListAllServer interface.
-type MockAPI_ListAllServer struct {
+// MockAPI_SaveItemsServer is a mock of API_SaveItemsServer interface.
+type MockAPI_SaveItemsServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockAPI_ListAllServerMockRecorder
+	recorder *MockAPI_SaveItemsServerMockRecorder
 }
 
-// MockAPI_ListAllServerMockRecorder is the mock recorder for MockAPI_ListAllServer.
-type MockAPI_ListAllServerMockRecorder struct {
-	mock *MockAPI_ListAllServer
+// MockAPI_SaveItemsServerMockRecorder is the mock recorder for MockAPI_SaveItemsServer.
+type MockAPI_SaveItemsServerMockRecorder struct {
+	mock *MockAPI_SaveItemsServer
 }
 
-// NewMockAPI_ListAllServer creates a new mock instance.
-func NewMockAPI_ListAllServer(ctrl *gomock.Controller) *MockAPI_ListAllServer {
-	mock := &MockAPI_ListAllServer{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListAllServerMockRecorder{mock}
+// NewMockAPI_SaveItemsServer creates a new mock instance.
+func NewMockAPI_SaveItemsServer(ctrl *gomock.Controller) *MockAPI_SaveItemsServer {
+	mock := &MockAPI_SaveItemsServer{ctrl: ctrl}
+	mock.recorder = &MockAPI_SaveItemsServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListAllServer) EXPECT() *MockAPI_ListAllServerMockRecorder {
+func (m *MockAPI_SaveItemsServer) EXPECT() *MockAPI_SaveItemsServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockAPI_ListAllServer) Context() context.Context {
+func (m *MockAPI_SaveItemsServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -645,251 +589,28 @@ func (m *MockAPI_ListAllServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockAPI_ListAllServerMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListAllServer)(nil).Context))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockAPI_ListAllServer) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListAllServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListAllServer)(nil).RecvMsg), m)
-}
-
-// Send mocks base method.
-func (m *MockAPI_ListAllServer) Send(arg0 *Item) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Send indicates an expected call of Send.
-func (mr *MockAPI_ListAllServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAPI_ListAllServer)(nil).Send), arg0)
-}
-
-// SendHeader mocks base method.
-func (m *MockAPI_ListAllServer) SendHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendHeader indicates an expected call of SendHeader.
-func (mr *MockAPI_ListAllServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAPI_ListAllServer)(nil).SendHeader), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockAPI_ListAllServer) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListAllServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListAllServer)(nil).SendMsg), m)
-}
-
-// SetHeader mocks base method.
-func (m *MockAPI_ListAllServer) SetHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockAPI_ListAllServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAPI_ListAllServer)(nil).SetHeader), arg0)
-}
-
-// SetTrailer mocks base method.
-func (m *MockAPI_ListAllServer) SetTrailer(arg0 metadata.MD) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetTrailer", arg0)
-}
-
-// SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockAPI_ListAllServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAPI_ListAllServer)(nil).SetTrailer), arg0)
-}
-
-// MockAPI_ListJobsServer is a mock of API_ListJobsServer interface.
-type MockAPI_ListJobsServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockAPI_ListJobsServerMockRecorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).Context))
 }
 
-// MockAPI_ListJobsServerMockRecorder is the mock recorder for MockAPI_ListJobsServer.
-type MockAPI_ListJobsServerMockRecorder struct {
-	mock *MockAPI_ListJobsServer
-}
-
-// NewMockAPI_ListJobsServer creates a new mock instance.
-func NewMockAPI_ListJobsServer(ctrl *gomock.Controller) *MockAPI_ListJobsServer {
-	mock := &MockAPI_ListJobsServer{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListJobsServerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListJobsServer) EXPECT() *MockAPI_ListJobsServerMockRecorder {
-	return m.recorder
-}
-
-// Context mocks base method.
-func (m *MockAPI_ListJobsServer) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockAPI_ListJobsServerMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).Context))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockAPI_ListJobsServer) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListJobsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).RecvMsg), m)
-}
-
-// Send mocks base method.
-func (m *MockAPI_ListJobsServer) Send(arg0 *Item) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Send indicates an expected call of Send.
-func (mr *MockAPI_ListJobsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).Send), arg0)
-}
-
-// SendHeader mocks base method.
-func (m *MockAPI_ListJobsServer) SendHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendHeader indicates an expected call of SendHeader.
-func (mr *MockAPI_ListJobsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).SendHeader), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockAPI_ListJobsServer) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListJobsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).SendMsg), m)
-}
-
-// SetHeader mocks base method.
-func (m *MockAPI_ListJobsServer) SetHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockAPI_ListJobsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).SetHeader), arg0)
-}
-
-// SetTrailer mocks base method.
-func (m *MockAPI_ListJobsServer) SetTrailer(arg0 metadata.MD) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetTrailer", arg0)
-}
-
-// SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockAPI_ListJobsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAPI_ListJobsServer)(nil).SetTrailer), arg0)
-}
-
-// MockAPI_ListStoriesServer is a mock of API_ListStoriesServer interface.
-type MockAPI_ListStoriesServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockAPI_ListStoriesServerMockRecorder
-}
-
-// MockAPI_ListStoriesServerMockRecorder is the mock recorder for MockAPI_ListStoriesServer.
-type MockAPI_ListStoriesServerMockRecorder struct {
-	mock *MockAPI_ListStoriesServer
-}
-
-// NewMockAPI_ListStoriesServer creates a new mock instance.
-func NewMockAPI_ListStoriesServer(ctrl *gomock.Controller) *MockAPI_ListStoriesServer {
-	mock := &MockAPI_ListStoriesServer{ctrl: ctrl}
-	mock.recorder = &MockAPI_ListStoriesServerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPI_ListStoriesServer) EXPECT() *MockAPI_ListStoriesServerMockRecorder {
-	return m.recorder
-}
-
-// Context mocks base method.
-func (m *MockAPI_ListStoriesServer) Context() context.Context {
+// Recv mocks base method.
+func (m *MockAPI_SaveItemsServer) Recv() (*Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Context indicates an expected call of Context.
-func (mr *MockAPI_ListStoriesServerMockRecorder) Context() *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockAPI_SaveItemsServerMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).Recv))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockAPI_ListStoriesServer) RecvMsg(m interface{}) error {
+func (m_2 *MockAPI_SaveItemsServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -897,27 +618,27 @@ func (m_2 *MockAPI_ListStoriesServer) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAPI_ListStoriesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).RecvMsg), m)
 }
 
-// Send mocks base method.
-func (m *MockAPI_ListStoriesServer) Send(arg0 *Item) error {
+// SendAndClose mocks base method.
+func (m *MockAPI_SaveItemsServer) SendAndClose(arg0 *SaveItemsResponse) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Send indicates an expected call of Send.
-func (mr *MockAPI_ListStoriesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockAPI_SaveItemsServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).SendAndClose), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockAPI_ListStoriesServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockAPI_SaveItemsServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -925,13 +646,13 @@ func (m *MockAPI_ListStoriesServer) SendHeader(arg0 metadata.MD) error {
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockAPI_ListStoriesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockAPI_ListStoriesServer) SendMsg(m interface{}) error {
+func (m_2 *MockAPI_SaveItemsServer) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -939,13 +660,13 @@ func (m_2 *MockAPI_ListStoriesServer) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockAPI_ListStoriesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).SendMsg), m)
 }
 
 // SetHeader mocks base method.
-func (m *MockAPI_ListStoriesServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockAPI_SaveItemsServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -953,19 +674,19 @@ func (m *MockAPI_ListStoriesServer) SetHeader(arg0 metadata.MD) error {
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockAPI_ListStoriesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockAPI_ListStoriesServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockAPI_SaveItemsServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockAPI_ListStoriesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockAPI_SaveItemsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAPI_ListStoriesServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAPI_SaveItemsServer)(nil).SetTrailer), arg0)
 }
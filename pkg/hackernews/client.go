@@ -1,30 +1,67 @@
 package hackernews
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"golang.org/x/time/rate"
 )
 
 type Client interface {
-	GetTopStories() ([]int, error)
-	GetItem(id int) (*model.Item, error)
+	GetTopStories(ctx context.Context) ([]int, error)
+	GetNewStories(ctx context.Context) ([]int, error)
+	GetBestStories(ctx context.Context) ([]int, error)
+	GetAskStories(ctx context.Context) ([]int, error)
+	GetShowStories(ctx context.Context) ([]int, error)
+	GetJobStories(ctx context.Context) ([]int, error)
+	GetItem(ctx context.Context, id int) (*model.Item, error)
+	GetUser(ctx context.Context, id string) (*model.User, error)
+	GetMaxItem(ctx context.Context) (int, error)
+	GetUpdates(ctx context.Context) (*model.Updates, error)
 }
 
 type client struct {
+	// The counters are updated atomically so are kept first to guarantee their 64-bit alignment
+	requests  int64
+	throttled int64
+	waitNanos int64
+
 	httpClient *http.Client
 	baseUrl    string
+	// timeout bounds each attempt of a request
+	timeout     time.Duration
+	maxRetries  int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	// limiter and inFlight throttle the requests made across every method, they are nil when unlimited
+	limiter  *rate.Limiter
+	inFlight chan struct{}
 }
 
+type ClientOptions func(c *client)
+
 const (
-	topStoriesPath = "%s/topstories.json"
-	itemPath       = "%s/item/%d.json"
+	topStoriesPath  = "%s/topstories.json"
+	newStoriesPath  = "%s/newstories.json"
+	bestStoriesPath = "%s/beststories.json"
+	askStoriesPath  = "%s/askstories.json"
+	showStoriesPath = "%s/showstories.json"
+	jobStoriesPath  = "%s/jobstories.json"
+	itemPath        = "%s/item/%d.json"
+	userPath        = "%s/user/%s.json"
+	maxItemPath     = "%s/maxitem.json"
+	updatesPath     = "%s/updates.json"
 )
 
-func New(baseUrl string, c *http.Client) (*client, error) {
+func New(baseUrl string, c *http.Client, opts ...ClientOptions) (*client, error) {
 	if c == nil {
 		c = http.DefaultClient
 	}
@@ -33,44 +70,182 @@ func New(baseUrl string, c *http.Client) (*client, error) {
 		return nil, errors.New("baseURL cannot be nil or empty")
 	}
 
-	return &client{httpClient: c, baseUrl: baseUrl}, nil
+	client := &client{
+		httpClient:  c,
+		baseUrl:     baseUrl,
+		timeout:     10 * time.Second,
+		maxRetries:  3,
+		baseBackoff: 500 * time.Millisecond,
+		maxBackoff:  30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
 }
 
-func (c *client) GetTopStories() ([]int, error) {
-	path := fmt.Sprintf(topStoriesPath, c.baseUrl)
-	var ids []int
-	if err := c.performRequest(path, http.MethodGet, &ids); err != nil {
-		return nil, err
+// WithTimeout sets how long each attempt of a request may take. A timeout of zero disables it
+func WithTimeout(timeout time.Duration) ClientOptions {
+	return func(c *client) {
+		c.timeout = timeout
 	}
+}
 
-	return ids, nil
+// WithMaxRetries sets how many times a request is retried after a transient error
+func WithMaxRetries(maxRetries int) ClientOptions {
+	return func(c *client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBackoff sets the delay before the first retry, which doubles on every further retry up to maxBackoff
+func WithBackoff(baseBackoff, maxBackoff time.Duration) ClientOptions {
+	return func(c *client) {
+		c.baseBackoff = baseBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+func (c *client) GetTopStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, topStoriesPath)
+}
+
+func (c *client) GetNewStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, newStoriesPath)
+}
+
+func (c *client) GetBestStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, bestStoriesPath)
+}
+
+func (c *client) GetAskStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, askStoriesPath)
+}
+
+func (c *client) GetShowStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, showStoriesPath)
 }
 
-func (c *client) GetItem(id int) (*model.Item, error) {
+func (c *client) GetJobStories(ctx context.Context) ([]int, error) {
+	return c.getStoryIds(ctx, jobStoriesPath)
+}
+
+func (c *client) GetItem(ctx context.Context, id int) (*model.Item, error) {
 	path := fmt.Sprintf(itemPath, c.baseUrl, id)
 	var item *model.Item
 
-	if err := c.performRequest(path, http.MethodGet, &item); err != nil {
+	if err := c.performRequest(ctx, path, http.MethodGet, &item); err != nil {
 		return nil, err
 	}
+	// Hacker News responds with null rather than a 404 for ids that do not exist
+	if item == nil {
+		return nil, fmt.Errorf("Unable to get item %d: %w", id, ErrItemNotFound)
+	}
 
 	return item, nil
 }
 
-func (c *client) performRequest(path, method string, result interface{}) error {
-	request, err := http.NewRequest(method, path, nil)
+func (c *client) GetUser(ctx context.Context, id string) (*model.User, error) {
+	path := fmt.Sprintf(userPath, c.baseUrl, url.PathEscape(id))
+	var user *model.User
+
+	if err := c.performRequest(ctx, path, http.MethodGet, &user); err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("Unable to get user %s: %w", id, ErrUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (c *client) GetMaxItem(ctx context.Context) (int, error) {
+	path := fmt.Sprintf(maxItemPath, c.baseUrl)
+	var maxItem int
+
+	if err := c.performRequest(ctx, path, http.MethodGet, &maxItem); err != nil {
+		return 0, err
+	}
+
+	return maxItem, nil
+}
+
+func (c *client) GetUpdates(ctx context.Context) (*model.Updates, error) {
+	path := fmt.Sprintf(updatesPath, c.baseUrl)
+	var updates *model.Updates
+
+	if err := c.performRequest(ctx, path, http.MethodGet, &updates); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+func (c *client) getStoryIds(ctx context.Context, pathTemplate string) ([]int, error) {
+	path := fmt.Sprintf(pathTemplate, c.baseUrl)
+	var ids []int
+	if err := c.performRequest(ctx, path, http.MethodGet, &ids); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// performRequest makes the request, retrying transient errors with exponential backoff and jitter until the
+// retries are exhausted or ctx is done
+func (c *client) performRequest(ctx context.Context, path, method string, result interface{}) error {
+	for attempt := 0; ; attempt++ {
+		err := c.attemptRequest(ctx, path, method, result)
+		if err == nil || !errors.Is(err, ErrTransient) || attempt >= c.maxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		wait := c.backoff(attempt)
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+			wait = statusErr.RetryAfter
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *client) attemptRequest(ctx context.Context, path, method string, result interface{}) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// The timeout starts once the request is let through, so that time spent throttled does not count against it
+	attemptCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	request, err := http.NewRequestWithContext(attemptCtx, method, path, nil)
 	if err != nil {
 		return fmt.Errorf("Failed to create http request object: %w", err)
 	}
 
 	response, err := c.httpClient.Do(request)
 	if err != nil {
-		return fmt.Errorf("Unable to make http call: %w", err)
+		// Only the caller cancelling the request is permanent, an attempt timing out is worth retrying
+		if ctx.Err() != nil {
+			return fmt.Errorf("Unable to make http call: %w", err)
+		}
+		return &transientError{err: err}
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
-		return fmt.Errorf("Unexpected status code returned. Got %d status code", response.StatusCode)
+		return &StatusError{StatusCode: response.StatusCode, RetryAfter: parseRetryAfter(response.Header.Get("Retry-After"))}
 	}
 
 	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
@@ -78,3 +253,34 @@ func (c *client) performRequest(path, method string, result interface{}) error {
 	}
 	return nil
 }
+
+// backoff returns a random delay of up to baseBackoff doubled for every previous attempt, capped at maxBackoff
+func (c *client) backoff(attempt int) time.Duration {
+	backoff := c.baseBackoff
+	for i := 0; i < attempt && backoff < c.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an http date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
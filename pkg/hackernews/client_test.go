@@ -1,6 +1,7 @@
 package hackernews_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -42,10 +43,10 @@ func TestGetTopStories(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			server := testServer(testValues.statusCode, testValues.responseBody, t)
 			defer server.Close()
-			client, err := hackernews.New(server.URL, server.Client())
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
 			assert.NoError(t, err, "Failed to create hackernews Client")
 
-			result, err := client.GetTopStories()
+			result, err := client.GetTopStories(context.TODO())
 			if testValues.expectedErr != "" {
 				assert.EqualErrorf(t, err, testValues.expectedErr, "Request failed should be: %v, got: %v", testValues.expectedErr, err)
 				assert.Nil(t, result)
@@ -60,6 +61,57 @@ func TestGetTopStories(t *testing.T) {
 	}
 }
 
+func TestGetFeeds(t *testing.T) {
+	expectedIds := []int{5, 6, 7}
+	responseBody, _ := json.Marshal(expectedIds)
+
+	tests := map[string]struct {
+		expectedPath string
+		getIds       func(client hackernews.Client, ctx context.Context) ([]int, error)
+	}{
+		"Top stories": {
+			expectedPath: "/topstories.json",
+			getIds:       hackernews.Client.GetTopStories,
+		},
+		"New stories": {
+			expectedPath: "/newstories.json",
+			getIds:       hackernews.Client.GetNewStories,
+		},
+		"Best stories": {
+			expectedPath: "/beststories.json",
+			getIds:       hackernews.Client.GetBestStories,
+		},
+		"Ask stories": {
+			expectedPath: "/askstories.json",
+			getIds:       hackernews.Client.GetAskStories,
+		},
+		"Show stories": {
+			expectedPath: "/showstories.json",
+			getIds:       hackernews.Client.GetShowStories,
+		},
+		"Job stories": {
+			expectedPath: "/jobstories.json",
+			getIds:       hackernews.Client.GetJobStories,
+		},
+	}
+	for testName, testValues := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, testValues.expectedPath, req.URL.Path)
+				_, err := rw.Write(responseBody)
+				assert.NoError(t, err, "Failed to write the body")
+			}))
+			defer server.Close()
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
+			assert.NoError(t, err, "Failed to create hackernews Client")
+
+			result, err := testValues.getIds(client, context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, expectedIds, result)
+		})
+	}
+}
+
 func TestGetItem(t *testing.T) {
 	successfulResponseBody, _ := json.Marshal(model.Item{
 		ID:        1,
@@ -72,6 +124,8 @@ func TestGetItem(t *testing.T) {
 		CreatedBy: "tel",
 		Dead:      false,
 		Deleted:   false,
+		Kids:      []int{2, 3},
+		Parts:     []int{4},
 	})
 	blankResponseBody, _ := json.Marshal(model.Item{})
 
@@ -96,10 +150,10 @@ func TestGetItem(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			server := testServer(testValues.statusCode, testValues.responseBody, t)
 			defer server.Close()
-			client, err := hackernews.New(server.URL, server.Client())
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
 			assert.NoError(t, err, "Failed to create hackernews Client")
 
-			result, err := client.GetItem(counter)
+			result, err := client.GetItem(context.TODO(), counter)
 			if testValues.expectedErr != "" {
 				assert.EqualErrorf(t, err, testValues.expectedErr, "Request failed should be: %v, got: %v", testValues.expectedErr, err)
 				assert.Nil(t, result)
@@ -114,6 +168,253 @@ func TestGetItem(t *testing.T) {
 	}
 }
 
+func TestGetItemNotFound(t *testing.T) {
+	server := testServer(http.StatusOK, []byte("null"), t)
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client())
+	assert.NoError(t, err, "Failed to create hackernews Client")
+
+	result, err := client.GetItem(context.TODO(), 99)
+	assert.EqualError(t, err, "Unable to get item 99: item not found")
+	assert.ErrorIs(t, err, hackernews.ErrItemNotFound)
+	assert.ErrorIs(t, err, hackernews.ErrNotFound)
+	assert.Nil(t, result)
+}
+
+func TestGetUser(t *testing.T) {
+	successfulResponseBody, _ := json.Marshal(model.User{
+		ID:        "jl",
+		Created:   1173923446,
+		Karma:     2937,
+		About:     "This is a test",
+		Submitted: []int{8265435, 8168423},
+	})
+
+	tests := map[string]testConfig{
+		"Successfully get user": {
+			responseBody: successfulResponseBody,
+			statusCode:   http.StatusOK,
+		},
+		"Request Failed with InternalServerError": {
+			responseBody: []byte("{}"),
+			statusCode:   http.StatusInternalServerError,
+			expectedErr:  "Unexpected status code returned. Got 500 status code",
+		},
+		"Invalid json body": {
+			responseBody: []byte("Hello"),
+			statusCode:   http.StatusOK,
+			expectedErr:  "Unabled to decode response: invalid character 'H' looking for beginning of value",
+		},
+	}
+	for testName, testValues := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "/user/jl.json", req.URL.Path)
+				rw.WriteHeader(testValues.statusCode)
+				_, err := rw.Write(testValues.responseBody)
+				assert.NoError(t, err, "Failed to write the body")
+			}))
+			defer server.Close()
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
+			assert.NoError(t, err, "Failed to create hackernews Client")
+
+			result, err := client.GetUser(context.TODO(), "jl")
+			if testValues.expectedErr != "" {
+				assert.EqualErrorf(t, err, testValues.expectedErr, "Request failed should be: %v, got: %v", testValues.expectedErr, err)
+				assert.Nil(t, result)
+			} else {
+				var expectedResult *model.User
+				_ = json.Unmarshal(testValues.responseBody, &expectedResult)
+				assert.Equal(t, expectedResult, result)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetUserNotFound(t *testing.T) {
+	server := testServer(http.StatusOK, []byte("null"), t)
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client())
+	assert.NoError(t, err, "Failed to create hackernews Client")
+
+	result, err := client.GetUser(context.TODO(), "missing")
+	assert.EqualError(t, err, "Unable to get user missing: user not found")
+	assert.ErrorIs(t, err, hackernews.ErrUserNotFound)
+	assert.Nil(t, result)
+}
+
+func TestGetMaxItem(t *testing.T) {
+	tests := map[string]testConfig{
+		"Successfully get max item": {
+			responseBody: []byte("8863"),
+			statusCode:   http.StatusOK,
+		},
+		"Request Failed with InternalServerError": {
+			responseBody: []byte("0"),
+			statusCode:   http.StatusInternalServerError,
+			expectedErr:  "Unexpected status code returned. Got 500 status code",
+		},
+	}
+	for testName, testValues := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server := testServer(testValues.statusCode, testValues.responseBody, t)
+			defer server.Close()
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
+			assert.NoError(t, err, "Failed to create hackernews Client")
+
+			result, err := client.GetMaxItem(context.TODO())
+			if testValues.expectedErr != "" {
+				assert.EqualErrorf(t, err, testValues.expectedErr, "Request failed should be: %v, got: %v", testValues.expectedErr, err)
+				assert.Zero(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 8863, result)
+			}
+		})
+	}
+}
+
+func TestGetUpdates(t *testing.T) {
+	successfulResponseBody, _ := json.Marshal(model.Updates{
+		Items:    []int{8423305, 8420805},
+		Profiles: []string{"thefox", "mdda"},
+	})
+
+	tests := map[string]testConfig{
+		"Successfully get updates": {
+			responseBody: successfulResponseBody,
+			statusCode:   http.StatusOK,
+		},
+		"Request Failed with InternalServerError": {
+			responseBody: []byte("{}"),
+			statusCode:   http.StatusInternalServerError,
+			expectedErr:  "Unexpected status code returned. Got 500 status code",
+		},
+	}
+	for testName, testValues := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server := testServer(testValues.statusCode, testValues.responseBody, t)
+			defer server.Close()
+			client, err := hackernews.New(server.URL, server.Client(), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
+			assert.NoError(t, err, "Failed to create hackernews Client")
+
+			result, err := client.GetUpdates(context.TODO())
+			if testValues.expectedErr != "" {
+				assert.EqualErrorf(t, err, testValues.expectedErr, "Request failed should be: %v, got: %v", testValues.expectedErr, err)
+				assert.Nil(t, result)
+			} else {
+				var expectedResult *model.Updates
+				_ = json.Unmarshal(testValues.responseBody, &expectedResult)
+				assert.Equal(t, expectedResult, result)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := map[string]struct {
+		statusCodes      []int
+		retryAfter       string
+		opts             []hackernews.ClientOptions
+		expectedAttempts int
+		expectedErr      string
+		expectedIs       error
+		minElapsed       time.Duration
+	}{
+		"Retries server errors until successful": {
+			statusCodes:      []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			expectedAttempts: 3,
+		},
+		"Gives up after the max retries": {
+			statusCodes:      []int{http.StatusInternalServerError},
+			opts:             []hackernews.ClientOptions{hackernews.WithMaxRetries(2)},
+			expectedAttempts: 3,
+			expectedErr:      "Unexpected status code returned. Got 500 status code",
+			expectedIs:       hackernews.ErrTransient,
+		},
+		"Not found is not retried": {
+			statusCodes:      []int{http.StatusNotFound},
+			expectedAttempts: 1,
+			expectedErr:      "Unexpected status code returned. Got 404 status code",
+			expectedIs:       hackernews.ErrNotFound,
+		},
+		"Rate limiting honours Retry-After": {
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:       "1",
+			expectedAttempts: 2,
+			minElapsed:       time.Second,
+		},
+	}
+	for testName, testValues := range tests {
+		t.Run(testName, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				statusCode := testValues.statusCodes[len(testValues.statusCodes)-1]
+				if attempts < len(testValues.statusCodes) {
+					statusCode = testValues.statusCodes[attempts]
+				}
+				attempts++
+				if testValues.retryAfter != "" {
+					rw.Header().Set("Retry-After", testValues.retryAfter)
+				}
+				rw.WriteHeader(statusCode)
+				_, err := rw.Write([]byte("8863"))
+				assert.NoError(t, err, "Failed to write the body")
+			}))
+			defer server.Close()
+			opts := append([]hackernews.ClientOptions{hackernews.WithBackoff(time.Millisecond, time.Millisecond)}, testValues.opts...)
+			client, err := hackernews.New(server.URL, server.Client(), opts...)
+			assert.NoError(t, err, "Failed to create hackernews Client")
+
+			started := time.Now()
+			result, err := client.GetMaxItem(context.TODO())
+			if testValues.expectedErr != "" {
+				assert.EqualError(t, err, testValues.expectedErr)
+				assert.ErrorIs(t, err, testValues.expectedIs)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 8863, result)
+			}
+			assert.Equal(t, testValues.expectedAttempts, attempts)
+			assert.GreaterOrEqual(t, time.Since(started), testValues.minElapsed)
+		})
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	t.Run("Request timeout is transient", func(t *testing.T) {
+		client, err := hackernews.New(server.URL, server.Client(), hackernews.WithTimeout(10*time.Millisecond), hackernews.WithMaxRetries(1), hackernews.WithBackoff(time.Millisecond, time.Millisecond))
+		assert.NoError(t, err, "Failed to create hackernews Client")
+
+		_, err = client.GetMaxItem(context.TODO())
+		assert.ErrorIs(t, err, hackernews.ErrTransient)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Cancelled context is not retried", func(t *testing.T) {
+		client, err := hackernews.New(server.URL, server.Client())
+		assert.NoError(t, err, "Failed to create hackernews Client")
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+		started := time.Now()
+		_, err = client.GetMaxItem(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotErrorIs(t, err, hackernews.ErrTransient)
+		assert.Less(t, time.Since(started), 500*time.Millisecond)
+	})
+}
+
 func TestNew(t *testing.T) {
 	tests := map[string]struct {
 		baseUrl            string
@@ -0,0 +1,58 @@
+package hackernews
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrNotFound is matched by errors returned when Hacker News responds with a 404
+	ErrNotFound = errors.New("not found")
+	// ErrTransient is matched by errors that may succeed if the request is retried, such as network failures,
+	// 5xx responses and rate limiting
+	ErrTransient = errors.New("transient error")
+	// ErrItemNotFound is returned by GetItem when Hacker News has no item with the requested id
+	ErrItemNotFound = fmt.Errorf("item %w", ErrNotFound)
+	// ErrUserNotFound is returned by GetUser when Hacker News has no user with the requested id
+	ErrUserNotFound = fmt.Errorf("user %w", ErrNotFound)
+)
+
+// StatusError is returned when Hacker News responds with a non 2xx status code
+type StatusError struct {
+	StatusCode int
+	// RetryAfter is the delay requested by the Retry-After header, or zero if it was not set
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Unexpected status code returned. Got %d status code", e.StatusCode)
+}
+
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrTransient:
+		return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// transientError marks a failure to complete the http call as retryable while keeping the underlying error
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return fmt.Sprintf("Unable to make http call: %v", e.err)
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+func (e *transientError) Is(target error) bool {
+	return target == ErrTransient
+}
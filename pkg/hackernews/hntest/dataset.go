@@ -0,0 +1,54 @@
+package hntest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+)
+
+//go:embed fixtures/dataset.json
+var defaultDataset []byte
+
+// Dataset is the data served by the fake Hacker News API
+type Dataset struct {
+	TopStories  []int         `json:"topstories"`
+	NewStories  []int         `json:"newstories"`
+	BestStories []int         `json:"beststories"`
+	AskStories  []int         `json:"askstories"`
+	ShowStories []int         `json:"showstories"`
+	JobStories  []int         `json:"jobstories"`
+	Items       []model.Item  `json:"items"`
+	Users       []model.User  `json:"users"`
+	Updates     model.Updates `json:"updates"`
+	// MaxItem defaults to the highest item id when it is not set
+	MaxItem int `json:"maxitem"`
+}
+
+// DefaultDataset returns a small dataset of stories, comments, a poll, a job and their authors
+func DefaultDataset() *Dataset {
+	dataset, err := decodeDataset(defaultDataset)
+	if err != nil {
+		panic(fmt.Sprintf("The embedded dataset is invalid. %v", err))
+	}
+	return dataset
+}
+
+// LoadDataset reads a dataset from a JSON fixture file
+func LoadDataset(path string) (*Dataset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the dataset file. %w", err)
+	}
+	return decodeDataset(data)
+}
+
+func decodeDataset(data []byte) (*Dataset, error) {
+	var dataset Dataset
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return nil, fmt.Errorf("Unable to decode the dataset. %w", err)
+	}
+	return &dataset, nil
+}
@@ -0,0 +1,196 @@
+// Package hntest provides a fake Hacker News API for tests and offline development
+package hntest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+)
+
+// Server serves a Dataset using the same paths and responses as the Hacker News API
+type Server struct {
+	mu       sync.Mutex
+	feeds    map[string][]int
+	items    map[int]model.Item
+	users    map[string]model.User
+	updates  model.Updates
+	maxItem  int
+	requests int
+
+	// latency delays every response
+	latency time.Duration
+	// errorRate is the fraction of requests answered with a 503
+	errorRate float64
+	// nullRate is the fraction of item and user requests answered with null, as if they did not exist
+	nullRate float64
+	random   *rand.Rand
+}
+
+type Options func(s *Server)
+
+// WithLatency delays every response by latency
+func WithLatency(latency time.Duration) Options {
+	return func(s *Server) {
+		s.latency = latency
+	}
+}
+
+// WithErrorRate answers the given fraction of requests, between 0 and 1, with a 503
+func WithErrorRate(rate float64) Options {
+	return func(s *Server) {
+		s.errorRate = rate
+	}
+}
+
+// WithNullRate answers the given fraction of item and user requests, between 0 and 1, with null
+func WithNullRate(rate float64) Options {
+	return func(s *Server) {
+		s.nullRate = rate
+	}
+}
+
+// WithSeed seeds the random source used to inject errors and nulls so that runs are repeatable
+func WithSeed(seed int64) Options {
+	return func(s *Server) {
+		s.random = rand.New(rand.NewSource(seed))
+	}
+}
+
+func New(dataset *Dataset, opts ...Options) *Server {
+	s := &Server{
+		feeds: map[string][]int{
+			"topstories":  dataset.TopStories,
+			"newstories":  dataset.NewStories,
+			"beststories": dataset.BestStories,
+			"askstories":  dataset.AskStories,
+			"showstories": dataset.ShowStories,
+			"jobstories":  dataset.JobStories,
+		},
+		items:   map[int]model.Item{},
+		users:   map[string]model.User{},
+		updates: dataset.Updates,
+		maxItem: dataset.MaxItem,
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, item := range dataset.Items {
+		s.items[item.ID] = item
+		if item.ID > s.maxItem {
+			s.maxItem = item.ID
+		}
+	}
+	for _, user := range dataset.Users {
+		s.users[user.ID] = user
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServer starts an httptest.Server serving the dataset. The server's URL is used as the Hacker News base url
+func NewServer(dataset *Dataset, opts ...Options) (*httptest.Server, *Server) {
+	fake := New(dataset, opts...)
+	return httptest.NewServer(fake), fake
+}
+
+// PutItem adds or replaces an item, raising the max item if needed
+func (s *Server) PutItem(item model.Item) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items[item.ID] = item
+	if item.ID > s.maxItem {
+		s.maxItem = item.ID
+	}
+}
+
+// PutUser adds or replaces a user
+func (s *Server) PutUser(user model.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[user.ID] = user
+}
+
+// SetUpdates replaces the items and profiles listed as changed
+func (s *Server) SetUpdates(updates model.Updates) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updates = updates
+}
+
+// Requests returns the number of requests served
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if s.latency > 0 {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-time.After(s.latency):
+		}
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v0")
+	if req.Method != http.MethodGet || !strings.HasSuffix(path, ".json") {
+		http.NotFound(rw, req)
+		return
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), ".json")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if s.chance(s.errorRate) {
+		http.Error(rw, "Service Unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	var body interface{}
+	switch {
+	case path == "maxitem":
+		body = s.maxItem
+	case path == "updates":
+		body = s.updates
+	case strings.HasPrefix(path, "item/"):
+		id, err := strconv.Atoi(strings.TrimPrefix(path, "item/"))
+		if err != nil {
+			http.Error(rw, "Invalid item id", http.StatusBadRequest)
+			return
+		}
+		if item, ok := s.items[id]; ok && !s.chance(s.nullRate) {
+			body = item
+		}
+	case strings.HasPrefix(path, "user/"):
+		if user, ok := s.users[strings.TrimPrefix(path, "user/")]; ok && !s.chance(s.nullRate) {
+			body = user
+		}
+	default:
+		ids, ok := s.feeds[path]
+		if !ok {
+			http.NotFound(rw, req)
+			return
+		}
+		body = ids
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// chance reports true for the given fraction of calls. It must be called with the mutex held
+func (s *Server) chance(rate float64) bool {
+	return rate > 0 && s.random.Float64() < rate
+}
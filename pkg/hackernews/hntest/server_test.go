@@ -0,0 +1,124 @@
+package hntest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews/hntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	server, _ := hntest.NewServer(hntest.DefaultDataset())
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client())
+	require.NoError(t, err, "Failed to create hackernews Client")
+	ctx := context.TODO()
+
+	ids, err := client.GetTopStories(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{8863, 126809, 192327}, ids)
+
+	ids, err = client.GetJobStories(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{192327}, ids)
+
+	item, err := client.GetItem(ctx, 126809)
+	assert.NoError(t, err)
+	assert.Equal(t, "poll", item.Type)
+	assert.Equal(t, []int{126810, 126811}, item.Parts)
+
+	user, err := client.GetUser(ctx, "dhouston")
+	assert.NoError(t, err)
+	assert.Equal(t, 3205, user.Karma)
+
+	maxItem, err := client.GetMaxItem(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 192327, maxItem)
+
+	updates, err := client.GetUpdates(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pg", "dhouston"}, updates.Profiles)
+
+	_, err = client.GetItem(ctx, 1)
+	assert.ErrorIs(t, err, hackernews.ErrItemNotFound)
+
+	_, err = client.GetUser(ctx, "unknown")
+	assert.ErrorIs(t, err, hackernews.ErrUserNotFound)
+}
+
+func TestServerKnobs(t *testing.T) {
+	tests := map[string]struct {
+		opts             []hntest.Options
+		clientOpts       []hackernews.ClientOptions
+		expectedIs       error
+		expectedRequests int
+	}{
+		"Nulls": {
+			opts:             []hntest.Options{hntest.WithNullRate(1)},
+			expectedIs:       hackernews.ErrItemNotFound,
+			expectedRequests: 1,
+		},
+		"Server errors": {
+			opts:             []hntest.Options{hntest.WithErrorRate(1)},
+			clientOpts:       []hackernews.ClientOptions{hackernews.WithMaxRetries(2)},
+			expectedIs:       hackernews.ErrTransient,
+			expectedRequests: 3,
+		},
+		"Latency": {
+			opts:       []hntest.Options{hntest.WithLatency(50 * time.Millisecond)},
+			clientOpts: []hackernews.ClientOptions{hackernews.WithTimeout(10 * time.Millisecond), hackernews.WithMaxRetries(0)},
+			expectedIs: hackernews.ErrTransient,
+		},
+	}
+	for testName, testConfig := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server, fake := hntest.NewServer(hntest.DefaultDataset(), append(testConfig.opts, hntest.WithSeed(1))...)
+			defer server.Close()
+			opts := append([]hackernews.ClientOptions{hackernews.WithBackoff(time.Millisecond, time.Millisecond)}, testConfig.clientOpts...)
+			client, err := hackernews.New(server.URL, server.Client(), opts...)
+			require.NoError(t, err, "Failed to create hackernews Client")
+
+			item, err := client.GetItem(context.TODO(), 8863)
+			assert.ErrorIs(t, err, testConfig.expectedIs)
+			assert.Nil(t, item)
+			if testConfig.expectedRequests > 0 {
+				assert.Equal(t, testConfig.expectedRequests, fake.Requests())
+			}
+		})
+	}
+}
+
+func TestPutItem(t *testing.T) {
+	server, fake := hntest.NewServer(&hntest.Dataset{})
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client())
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	fake.PutItem(model.Item{ID: 42, Type: "story"})
+
+	maxItem, err := client.GetMaxItem(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 42, maxItem)
+	item, err := client.GetItem(context.TODO(), 42)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.Item{ID: 42, Type: "story"}, item)
+}
+
+func TestLoadDataset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dataset.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"topstories": [1], "items": [{"id": 1, "type": "story"}], "maxitem": 5}`), 0o644))
+
+	dataset, err := hntest.LoadDataset(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &hntest.Dataset{TopStories: []int{1}, Items: []model.Item{{ID: 1, Type: "story"}}, MaxItem: 5}, dataset)
+
+	_, err = hntest.LoadDataset(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Stats reports how requests have been throttled by the client's rate limit and in-flight cap
+type Stats struct {
+	// Requests is the number of http calls made, including retries
+	Requests int64
+	// Throttled is the number of http calls that had to wait before being made
+	Throttled int64
+	// Wait is the total time http calls spent waiting
+	Wait time.Duration
+}
+
+// Sub returns the throttling that happened between earlier and s
+func (s Stats) Sub(earlier Stats) Stats {
+	return Stats{
+		Requests:  s.Requests - earlier.Requests,
+		Throttled: s.Throttled - earlier.Throttled,
+		Wait:      s.Wait - earlier.Wait,
+	}
+}
+
+// StatsReporter is implemented by clients that record throttling statistics
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// WithRateLimit limits the requests made by the client to requestsPerSecond, allowing bursts of up to burst requests.
+// A burst below 1 allows one request at a time, as a limiter without a burst never lets a request through. The limit is
+// shared by every method of the client
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOptions {
+	return func(c *client) {
+		if burst < 1 {
+			burst = 1
+		}
+		if requestsPerSecond > 0 {
+			c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+		}
+	}
+}
+
+// WithMaxInFlight caps the number of requests the client makes concurrently
+func WithMaxInFlight(maxInFlight int) ClientOptions {
+	return func(c *client) {
+		if maxInFlight > 0 {
+			c.inFlight = make(chan struct{}, maxInFlight)
+		}
+	}
+}
+
+func (c *client) Stats() Stats {
+	return Stats{
+		Requests:  atomic.LoadInt64(&c.requests),
+		Throttled: atomic.LoadInt64(&c.throttled),
+		Wait:      time.Duration(atomic.LoadInt64(&c.waitNanos)),
+	}
+}
+
+// acquire waits for a slot and a rate limit token. The returned func must be called to release the slot
+func (c *client) acquire(ctx context.Context) (func(), error) {
+	started := time.Now()
+	release := func() {}
+	if c.inFlight != nil {
+		select {
+		case c.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Unable to acquire a request slot: %w", ctx.Err())
+		}
+		release = func() { <-c.inFlight }
+	}
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, fmt.Errorf("Unable to acquire a rate limit token: %w", err)
+		}
+	}
+
+	atomic.AddInt64(&c.requests, 1)
+	// Anything under a millisecond is the cost of acquiring rather than waiting
+	if wait := time.Since(started); wait > time.Millisecond {
+		atomic.AddInt64(&c.throttled, 1)
+		atomic.AddInt64(&c.waitNanos, int64(wait))
+	}
+	return release, nil
+}
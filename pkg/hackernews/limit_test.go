@@ -0,0 +1,127 @@
+package hackernews_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/emmaLP/gs-software-onboarding/pkg/hackernews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	server := testServer(http.StatusOK, []byte("8863"), t)
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client(), hackernews.WithRateLimit(20, 1))
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	started := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.GetMaxItem(context.TODO())
+		require.NoError(t, err)
+	}
+
+	// The first request uses the burst, the next two wait 50ms each for a token
+	assert.GreaterOrEqual(t, time.Since(started), 90*time.Millisecond)
+	stats := client.Stats()
+	assert.Equal(t, int64(3), stats.Requests)
+	assert.Equal(t, int64(2), stats.Throttled)
+	assert.GreaterOrEqual(t, stats.Wait, 90*time.Millisecond)
+}
+
+func TestRateLimitWithoutBurst(t *testing.T) {
+	server := testServer(http.StatusOK, []byte("8863"), t)
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client(), hackernews.WithRateLimit(20, 0))
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetMaxItem(context.TODO())
+		require.NoError(t, err, "A burst of 0 should allow one request at a time")
+	}
+	assert.Equal(t, int64(1), client.Stats().Throttled)
+}
+
+func TestRateLimitCancelled(t *testing.T) {
+	server := testServer(http.StatusOK, []byte("8863"), t)
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client(), hackernews.WithRateLimit(0.1, 1))
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	_, err = client.GetMaxItem(context.TODO())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetMaxItem(ctx)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, hackernews.ErrTransient)
+}
+
+func TestTimeoutExcludesThrottling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(30 * time.Millisecond)
+		_, err := rw.Write([]byte("8863"))
+		assert.NoError(t, err, "Failed to write the body")
+	}))
+	defer server.Close()
+	// Each request takes 30ms of its 50ms timeout, so the second request waiting on the first for its slot would time
+	// out if the wait counted against its timeout
+	client, err := hackernews.New(server.URL, server.Client(), hackernews.WithMaxInFlight(1),
+		hackernews.WithTimeout(50*time.Millisecond), hackernews.WithMaxRetries(0))
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetMaxItem(context.TODO())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	stats := client.Stats()
+	assert.Equal(t, int64(2), stats.Requests)
+	assert.Equal(t, int64(1), stats.Throttled)
+}
+
+func TestMaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		current := atomic.AddInt64(&inFlight, 1)
+		defer atomic.AddInt64(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt64(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt64(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, err := rw.Write([]byte("8863"))
+		assert.NoError(t, err, "Failed to write the body")
+	}))
+	defer server.Close()
+	client, err := hackernews.New(server.URL, server.Client(), hackernews.WithMaxInFlight(2))
+	require.NoError(t, err, "Failed to create hackernews Client")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetMaxItem(context.TODO())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt64(&maxInFlight), int64(2))
+	assert.Equal(t, int64(6), client.Stats().Requests)
+}
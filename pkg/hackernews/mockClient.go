@@ -1,6 +1,8 @@
 package hackernews
 
 import (
+	"context"
+
 	"github.com/emmaLP/gs-software-onboarding/pkg/common/model"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,24 +11,73 @@ type Mock struct {
 	mock.Mock
 }
 
-func (m *Mock) GetTopStories() ([]int, error) {
-	args := m.Called()
+func (m *Mock) GetTopStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
 
-	idsArg, ok := args.Get(0).([]int)
+func (m *Mock) GetNewStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
+
+func (m *Mock) GetBestStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
+
+func (m *Mock) GetAskStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
+
+func (m *Mock) GetShowStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
+
+func (m *Mock) GetJobStories(ctx context.Context) ([]int, error) {
+	return storyIds(m.Called(ctx))
+}
+
+func (m *Mock) GetItem(ctx context.Context, id int) (*model.Item, error) {
+	args := m.Called(ctx, id)
+
+	itemArg, ok := args.Get(0).(*model.Item)
 	if !ok {
 		return nil, args.Error(1)
 	}
 
-	return idsArg, args.Error(1)
+	return itemArg, args.Error(1)
 }
 
-func (m *Mock) GetItem(id int) (*model.Item, error) {
-	args := m.Called(id)
+func (m *Mock) GetUser(ctx context.Context, id string) (*model.User, error) {
+	args := m.Called(ctx, id)
 
-	itemArg, ok := args.Get(0).(*model.Item)
+	userArg, ok := args.Get(0).(*model.User)
 	if !ok {
 		return nil, args.Error(1)
 	}
 
-	return itemArg, args.Error(1)
+	return userArg, args.Error(1)
+}
+
+func (m *Mock) GetMaxItem(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *Mock) GetUpdates(ctx context.Context) (*model.Updates, error) {
+	args := m.Called(ctx)
+
+	updatesArg, ok := args.Get(0).(*model.Updates)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return updatesArg, args.Error(1)
+}
+
+func storyIds(args mock.Arguments) ([]int, error) {
+	idsArg, ok := args.Get(0).([]int)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return idsArg, args.Error(1)
 }
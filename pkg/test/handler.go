@@ -36,6 +36,8 @@ func LoadTestHandler(t *testing.T, ctx context.Context) *testHandler {
 	require.NoError(t, err)
 	dbClient, err := database.New(ctx, logger, &conf.Database)
 	require.NoError(t, err)
+	_, err = dbClient.Migrate(ctx)
+	require.NoError(t, err)
 	cacheClient, err := caching.New(ctx, conf.Cache.Address, dbClient, logger, caching.WithTTL(10*time.Millisecond))
 	require.NoError(t, err)
 	return &testHandler{